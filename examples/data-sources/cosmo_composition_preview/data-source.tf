@@ -0,0 +1,20 @@
+data "cosmo_composition_preview" "example" {
+  federated_graph = var.federated_graph
+  namespace       = var.namespace
+  label_matchers  = ["team=backend"]
+
+  subgraphs = [
+    {
+      name   = "users"
+      schema = "type Query { users: [User!]! } type User @key(fields: \"id\") { id: ID! name: String! }"
+    },
+    {
+      name   = "products"
+      schema = "type Query { products: [Product!]! } type Product @key(fields: \"upc\") { upc: ID! price: Int! }"
+    }
+  ]
+}
+
+output "preview_errors" {
+  value = [for subgraph in data.cosmo_composition_preview.example.subgraphs : subgraph.composition_errors if !subgraph.is_composable]
+}
@@ -0,0 +1,10 @@
+variable "federated_graph" {
+  description = "The name of the federated graph whose label matchers are checked"
+  type        = string
+}
+
+variable "namespace" {
+  description = "The namespace of the federated graph and the subgraphs"
+  type        = string
+  default     = "default"
+}
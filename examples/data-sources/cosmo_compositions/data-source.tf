@@ -0,0 +1,10 @@
+data "cosmo_compositions" "example" {
+  federated_graph = var.federated_graph
+  namespace       = var.namespace
+  start_date      = "2024-09-01T00:00:00Z"
+  limit           = 5
+}
+
+output "failed_compositions" {
+  value = [for composition in data.cosmo_compositions.example.compositions : composition.composition_errors if !composition.is_composable]
+}
@@ -0,0 +1,10 @@
+variable "federated_graph" {
+  description = "The name of the federated graph to list the compositions of"
+  type        = string
+}
+
+variable "namespace" {
+  description = "The namespace of the federated graph"
+  type        = string
+  default     = "default"
+}
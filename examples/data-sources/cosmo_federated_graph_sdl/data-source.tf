@@ -0,0 +1,8 @@
+data "cosmo_federated_graph_sdl" "example" {
+  name      = var.name
+  namespace = var.namespace
+}
+
+output "client_schema" {
+  value = data.cosmo_federated_graph_sdl.example.client_schema
+}
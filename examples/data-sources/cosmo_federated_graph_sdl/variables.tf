@@ -0,0 +1,10 @@
+variable "name" {
+  description = "The name of the federated graph, monograph or contract to retrieve the schemas of"
+  type        = string
+}
+
+variable "namespace" {
+  description = "The namespace of the federated graph, monograph or contract"
+  type        = string
+  default     = "default"
+}
@@ -0,0 +1,4 @@
+data "cosmo_router_config" "example" {
+  name      = var.name
+  namespace = var.namespace
+}
@@ -0,0 +1,10 @@
+variable "name" {
+  description = "The name of the federated graph or contract to retrieve the router config for"
+  type        = string
+}
+
+variable "namespace" {
+  description = "The namespace of the federated graph or contract"
+  type        = string
+  default     = "default"
+}
@@ -3,4 +3,5 @@ resource "cosmo_monograph" "example" {
   namespace   = var.monograph_namespace
   graph_url   = var.monograph_graph_url
   routing_url = var.monograph_routing_url
+  schema      = var.monograph_schema
 }
\ No newline at end of file
@@ -12,4 +12,11 @@ variable "monograph_graph_url" {
 
 variable "monograph_routing_url" {
   default = "http://example.com/routing"
-}
\ No newline at end of file
+}
+variable "monograph_schema" {
+  default = <<-EOT
+    type Query {
+      hello: String
+    }
+  EOT
+}
@@ -0,0 +1,11 @@
+output "login_url" {
+  value = cosmo_oidc_provider.test.login_url
+}
+
+output "sign_in_redirect_url" {
+  value = cosmo_oidc_provider.test.sign_in_redirect_url
+}
+
+output "sign_out_redirect_url" {
+  value = cosmo_oidc_provider.test.sign_out_redirect_url
+}
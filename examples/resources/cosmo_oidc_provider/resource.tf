@@ -0,0 +1,17 @@
+resource "cosmo_oidc_provider" "test" {
+  name               = var.name
+  discovery_endpoint = var.discovery_endpoint
+  client_id          = var.client_id
+  client_secret      = var.client_secret
+
+  mappers = [
+    {
+      role      = "admin"
+      sso_group = "platform-admins"
+    },
+    {
+      role      = "developer"
+      sso_group = "engineering"
+    },
+  ]
+}
@@ -0,0 +1,16 @@
+variable "name" {
+  type = string
+}
+
+variable "discovery_endpoint" {
+  type = string
+}
+
+variable "client_id" {
+  type = string
+}
+
+variable "client_secret" {
+  type      = string
+  sensitive = true
+}
@@ -0,0 +1,7 @@
+output "operation_hashes" {
+  value = cosmo_persisted_operations.directory.operation_hashes
+}
+
+output "published_operations" {
+  value = cosmo_persisted_operations.directory.published_operations
+}
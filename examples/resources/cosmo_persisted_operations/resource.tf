@@ -0,0 +1,15 @@
+resource "cosmo_persisted_operations" "inline" {
+  federated_graph = var.federated_graph
+  namespace       = var.namespace
+  client_name     = var.client_name
+  operations = [
+    "query Company { company { ceo } }",
+  ]
+}
+
+resource "cosmo_persisted_operations" "directory" {
+  federated_graph = var.federated_graph
+  namespace       = var.namespace
+  client_name     = var.client_name
+  directory       = "${path.module}/operations"
+}
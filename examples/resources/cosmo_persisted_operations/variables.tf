@@ -0,0 +1,11 @@
+variable "federated_graph" {
+  type = string
+}
+
+variable "namespace" {
+  type = string
+}
+
+variable "client_name" {
+  type = string
+}
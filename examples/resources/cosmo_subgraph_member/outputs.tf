@@ -0,0 +1,7 @@
+output "id" {
+  value = cosmo_subgraph_member.test.id
+}
+
+output "user_id" {
+  value = cosmo_subgraph_member.test.user_id
+}
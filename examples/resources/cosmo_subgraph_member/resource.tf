@@ -0,0 +1,5 @@
+resource "cosmo_subgraph_member" "test" {
+  subgraph  = var.subgraph
+  namespace = var.namespace
+  email     = var.email
+}
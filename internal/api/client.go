@@ -9,14 +9,22 @@ import (
 	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/utils"
 )
 
+const DefaultCdnUrl = "https://cosmo-cdn.wundergraph.com"
+
 type PlatformClient struct {
 	Client      platformv1connect.PlatformServiceClient
 	cosmoApiKey string
+	cosmoCdnUrl string
+	cdnClient   *http.Client
+
+	// CompositionFailureMode is the provider wide default of how resources handle a failed composition.
+	CompositionFailureMode string
 }
 
-func NewClient(apiKey, apiUrl string) (*PlatformClient, error) {
+func NewClient(apiKey, apiUrl, cdnUrl string) (*PlatformClient, error) {
 	cosmoApiKey := apiKey
 	cosmoApiUrl := apiUrl
+	cosmoCdnUrl := cdnUrl
 
 	envApiKey, ok := os.LookupEnv(utils.EnvCosmoApiKey)
 	if !ok && cosmoApiKey == "" {
@@ -36,6 +44,15 @@ func NewClient(apiKey, apiUrl string) (*PlatformClient, error) {
 		cosmoApiUrl = envApiUrl
 	}
 
+	envCdnUrl, ok := os.LookupEnv(utils.EnvCosmoCdnUrl)
+	if ok {
+		cosmoCdnUrl = envCdnUrl
+	}
+
+	if cosmoCdnUrl == "" {
+		cosmoCdnUrl = DefaultCdnUrl
+	}
+
 	transport := &http.Transport{
 		Proxy: http.ProxyFromEnvironment,
 	}
@@ -52,6 +69,11 @@ func NewClient(apiKey, apiUrl string) (*PlatformClient, error) {
 	return &PlatformClient{
 		Client:      client,
 		cosmoApiKey: cosmoApiKey,
+		cosmoCdnUrl: cosmoCdnUrl,
+		// the cdn authenticates with graph tokens instead of the api key
+		cdnClient: &http.Client{
+			Transport: transport,
+		},
 	}, nil
 }
 
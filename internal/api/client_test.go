@@ -11,7 +11,7 @@ func TestNewClientFromPassedVariables(t *testing.T) {
 	os.Unsetenv("COSMO_API_KEY")
 	os.Unsetenv("COSMO_API_URL")
 
-	client, err := api.NewClient("passed_api_key", "https://passed-url.com")
+	client, err := api.NewClient("passed_api_key", "https://passed-url.com", "")
 	if err != nil {
 		t.Errorf("Expected client with passed variables, got error: %v", err)
 	}
@@ -25,7 +25,7 @@ func TestNewClientFromEnvironment(t *testing.T) {
 	os.Setenv("COSMO_API_KEY", "env_api_key")
 	os.Setenv("COSMO_API_URL", "https://env-url.com")
 
-	client, err := api.NewClient("", "")
+	client, err := api.NewClient("", "", "")
 	if err != nil {
 		t.Errorf("Expected client with env variables, got error: %v", err)
 	}
@@ -41,7 +41,7 @@ func TestNewClientFromEnvironment(t *testing.T) {
 func TestNewClientFromEnvironmentWithoutApiKey(t *testing.T) {
 	os.Setenv("COSMO_API_URL", "https://env-url.com")
 
-	client, err := api.NewClient("", "")
+	client, err := api.NewClient("", "", "")
 	if err == nil {
 		t.Errorf("Expected client creation to fail but got client: %v", err)
 	}
@@ -57,7 +57,7 @@ func TestNewClientFromEnvironmentWithoutApiUrlAndApiKey(t *testing.T) {
 	os.Unsetenv("COSMO_API_KEY")
 	os.Unsetenv("COSMO_API_URL")
 
-	client, err := api.NewClient("", "")
+	client, err := api.NewClient("", "", "")
 	if err == nil {
 		t.Errorf("Expected client not to be created: %v", err)
 	}
@@ -71,7 +71,7 @@ func TestNewClientFromEnvironmentWithApiKey(t *testing.T) {
 	os.Unsetenv("COSMO_API_KEY")
 	os.Unsetenv("COSMO_API_URL")
 
-	client, err := api.NewClient("cosmo_api_key", "")
+	client, err := api.NewClient("cosmo_api_key", "", "")
 	if err != nil {
 		t.Errorf("Expected client to be created but got error: %v", err)
 	}
@@ -22,6 +22,15 @@ func resolveWebsocketSubprotocol(protocol string) *common.GraphQLWebsocketSubpro
 	}
 }
 
+// resolveOptionalWebsocketSubprotocol resolves the protocol only when it is set, an empty value
+// resets it to the default.
+func resolveOptionalWebsocketSubprotocol(protocol *string) *common.GraphQLWebsocketSubprotocol {
+	if protocol == nil {
+		return nil
+	}
+	return resolveWebsocketSubprotocol(*protocol)
+}
+
 const (
 	GraphQLSubscriptionProtocolWS      = "ws"
 	GraphQLSubscriptionProtocolSSE     = "sse"
@@ -39,3 +48,12 @@ func resolveSubscriptionProtocol(protocol string) *common.GraphQLSubscriptionPro
 		return common.GraphQLSubscriptionProtocol_GRAPHQL_SUBSCRIPTION_PROTOCOL_WS.Enum()
 	}
 }
+
+// resolveOptionalSubscriptionProtocol resolves the protocol only when it is set, an empty value
+// resets it to the default.
+func resolveOptionalSubscriptionProtocol(protocol *string) *common.GraphQLSubscriptionProtocol {
+	if protocol == nil {
+		return nil
+	}
+	return resolveSubscriptionProtocol(*protocol)
+}
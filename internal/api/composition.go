@@ -0,0 +1,168 @@
+package api
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/common"
+	platformv1 "github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/platform/v1"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/utils"
+)
+
+// The composition failure modes define how resources handle a failed composition: `warn` reports it as a warning,
+// `error` fails the apply and `rollback` additionally republishes the previously stored schema.
+const (
+	CompositionFailureModeWarn     = "warn"
+	CompositionFailureModeError    = "error"
+	CompositionFailureModeRollback = "rollback"
+)
+
+// ResolveCompositionFailureMode returns the mode configured on a resource, falling back to the provider wide
+// default and to `warn`.
+func (p *PlatformClient) ResolveCompositionFailureMode(mode string) string {
+	if mode != "" {
+		return mode
+	}
+
+	if p.CompositionFailureMode != "" {
+		return p.CompositionFailureMode
+	}
+
+	return CompositionFailureModeWarn
+}
+
+// CompositionFailureSeverity returns the severity a failed composition is reported with, a warning in `warn`
+// mode and an error otherwise.
+func CompositionFailureSeverity(mode string) diag.Severity {
+	if mode == CompositionFailureModeWarn {
+		return diag.SeverityWarning
+	}
+	return diag.SeverityError
+}
+
+// CompositionReport holds the composition and deployment errors returned by a mutation that triggered
+// a composition of one or more federated graphs.
+type CompositionReport struct {
+	CompositionErrors []*platformv1.CompositionError
+	DeploymentErrors  []*platformv1.DeploymentError
+}
+
+func newCompositionReport(compositionErrors []*platformv1.CompositionError, deploymentErrors []*platformv1.DeploymentError) *CompositionReport {
+	if len(compositionErrors) == 0 && len(deploymentErrors) == 0 {
+		return nil
+	}
+
+	return &CompositionReport{
+		CompositionErrors: compositionErrors,
+		DeploymentErrors:  deploymentErrors,
+	}
+}
+
+// Issues returns the composition errors followed by the deployment errors of the report.
+func (r *CompositionReport) Issues() []utils.CompositionIssue {
+	var issues []utils.CompositionIssue
+	for _, compositionError := range r.CompositionErrors {
+		issues = append(issues, compositionError)
+	}
+	for _, deploymentError := range r.DeploymentErrors {
+		issues = append(issues, deploymentError)
+	}
+	return issues
+}
+
+// handleCompositionErrorCodes works like handleErrorCodes for responses of mutations that trigger a composition,
+// the composition and deployment errors are attached to the returned error as a report instead of being
+// dumped into its reason.
+func handleCompositionErrorCodes(response *platformv1.Response, compositionErrors []*platformv1.CompositionError, deploymentErrors []*platformv1.DeploymentError, reason string) *ApiError {
+	report := newCompositionReport(compositionErrors, deploymentErrors)
+	if report == nil {
+		return handleErrorCodes(response.GetCode(), reason)
+	}
+
+	apiError := handleErrorCodes(response.GetCode(), response.GetDetails())
+	if apiError != nil {
+		apiError.Report = report
+	}
+
+	return apiError
+}
+
+// GetCompositions lists the compositions of a federated graph created between startDate and endDate,
+// both formatted as RFC 3339 timestamps, most recent first.
+func (p PlatformClient) GetCompositions(ctx context.Context, fedGraphName, namespace, startDate, endDate string, limit, offset int32) ([]*platformv1.GraphComposition, *ApiError) {
+	request := connect.NewRequest(&platformv1.GetCompositionsRequest{
+		FedGraphName:                   fedGraphName,
+		Namespace:                      namespace,
+		StartDate:                      startDate,
+		EndDate:                        endDate,
+		Limit:                          limit,
+		Offset:                         offset,
+		ExcludeFeatureFlagCompositions: true,
+	})
+	response, err := p.Client.GetCompositions(ctx, request)
+	if err != nil {
+		return nil, &ApiError{Err: err, Reason: "GetCompositions", Status: common.EnumStatusCode_ERR}
+	}
+
+	if response.Msg == nil {
+		return nil, &ApiError{Err: ErrEmptyMsg, Reason: "GetCompositions", Status: common.EnumStatusCode_ERR}
+	}
+
+	apiError := handleErrorCodes(response.Msg.GetResponse().Code, response.Msg.String())
+	if apiError != nil {
+		return nil, apiError
+	}
+
+	return response.Msg.GetCompositions(), nil
+}
+
+// CheckSubgraphSchema checks the composition of a proposed subgraph schema with the published schemas of the
+// other subgraphs, without publishing it. Composition errors are part of the response.
+func (p PlatformClient) CheckSubgraphSchema(ctx context.Context, name, namespace, schema string) (*platformv1.CheckSubgraphSchemaResponse, *ApiError) {
+	request := connect.NewRequest(&platformv1.CheckSubgraphSchemaRequest{
+		SubgraphName: name,
+		Namespace:    namespace,
+		Schema:       []byte(schema),
+	})
+	response, err := p.Client.CheckSubgraphSchema(ctx, request)
+	if err != nil {
+		return nil, &ApiError{Err: err, Reason: "CheckSubgraphSchema", Status: common.EnumStatusCode_ERR}
+	}
+
+	if response.Msg == nil {
+		return nil, &ApiError{Err: ErrEmptyMsg, Reason: "CheckSubgraphSchema", Status: common.EnumStatusCode_ERR}
+	}
+
+	apiError := handleErrorCodes(response.Msg.GetResponse().Code, response.Msg.String())
+	if apiError != nil {
+		return nil, apiError
+	}
+
+	return response.Msg, nil
+}
+
+// CheckFederatedGraph checks the composition of the published subgraphs matched by the label matchers,
+// without creating or updating the federated graph. Composition errors are part of the response.
+func (p PlatformClient) CheckFederatedGraph(ctx context.Context, name, namespace string, labelMatchers []string) (*platformv1.CheckFederatedGraphResponse, *ApiError) {
+	request := connect.NewRequest(&platformv1.CheckFederatedGraphRequest{
+		Name:          name,
+		Namespace:     namespace,
+		LabelMatchers: labelMatchers,
+	})
+	response, err := p.Client.CheckFederatedGraph(ctx, request)
+	if err != nil {
+		return nil, &ApiError{Err: err, Reason: "CheckFederatedGraph", Status: common.EnumStatusCode_ERR}
+	}
+
+	if response.Msg == nil {
+		return nil, &ApiError{Err: ErrEmptyMsg, Reason: "CheckFederatedGraph", Status: common.EnumStatusCode_ERR}
+	}
+
+	apiError := handleErrorCodes(response.Msg.GetResponse().Code, response.Msg.String())
+	if apiError != nil {
+		return nil, apiError
+	}
+
+	return response.Msg, nil
+}
@@ -0,0 +1,49 @@
+package api_test
+
+import (
+	"testing"
+
+	platformv1 "github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/platform/v1"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/api"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/utils"
+)
+
+func TestCompositionIssuesWithoutReport(t *testing.T) {
+	apiError := &api.ApiError{Err: api.ErrSubgraphCompositionFailed, Reason: "composition failed"}
+
+	if issues := apiError.CompositionIssues(); issues != nil {
+		t.Errorf("Expected no composition issues, got %v", issues)
+	}
+}
+
+func TestCompositionIssuesFromReport(t *testing.T) {
+	apiError := &api.ApiError{
+		Err: api.ErrSubgraphCompositionFailed,
+		Report: &api.CompositionReport{
+			CompositionErrors: []*platformv1.CompositionError{
+				{Message: "Field \"Query.hello\" is defined twice", FederatedGraphName: "products", Namespace: "staging"},
+				{Message: "Unknown type \"Product\"", FederatedGraphName: "products", Namespace: "staging", FeatureFlag: "new-checkout"},
+			},
+			DeploymentErrors: []*platformv1.DeploymentError{
+				{Message: "Admission webhook rejected the config", FederatedGraphName: "store", Namespace: "production"},
+			},
+		},
+	}
+
+	expected := []string{
+		"federated graph 'products' in namespace 'staging': Field \"Query.hello\" is defined twice",
+		"federated graph 'products' in namespace 'staging' (feature flag 'new-checkout'): Unknown type \"Product\"",
+		"federated graph 'store' in namespace 'production': Admission webhook rejected the config",
+	}
+
+	issues := apiError.CompositionIssues()
+	if len(issues) != len(expected) {
+		t.Fatalf("Expected %d composition issues, got %d", len(expected), len(issues))
+	}
+
+	for i, issue := range issues {
+		if message := utils.FormatCompositionIssue(issue); message != expected[i] {
+			t.Errorf("Expected issue %d to be %q, got %q", i, expected[i], message)
+		}
+	}
+}
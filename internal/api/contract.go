@@ -37,7 +37,9 @@ func (p *PlatformClient) CreateContract(ctx context.Context, name, namespace, so
 	return response.Msg, nil
 }
 
-func (p *PlatformClient) UpdateContract(ctx context.Context, name, namespace string, excludeTags []string) (*platformv1.UpdateContractResponse, *ApiError) {
+// UpdateContract updates the tag filters of a contract and, as the contract rpc only carries those, its
+// routing URL, readme and admission webhook through the federated graph the contract is backed by.
+func (p *PlatformClient) UpdateContract(ctx context.Context, name, namespace string, excludeTags []string, routingUrl string, admissionWebhookUrl, admissionWebhookSecret, readme *string) (*platformv1.UpdateContractResponse, *ApiError) {
 	request := connect.NewRequest(&platformv1.UpdateContractRequest{
 		Name:        name,
 		Namespace:   namespace,
@@ -53,7 +55,27 @@ func (p *PlatformClient) UpdateContract(ctx context.Context, name, namespace str
 		return nil, &ApiError{Err: ErrEmptyMsg, Reason: "UpdateContract", Status: common.EnumStatusCode_ERR}
 	}
 
-	apiError := handleErrorCodes(response.Msg.GetResponse().Code, response.Msg.String())
+	contractError := handleErrorCodes(response.Msg.GetResponse().Code, response.Msg.String())
+	if contractError != nil && !isCompositionFailedError(contractError) {
+		return nil, contractError
+	}
+
+	// a failed composition still stores the tag filters, so the remaining fields are updated regardless
+	_, apiError := p.UpdateFederatedGraph(ctx, admissionWebhookSecret, &platformv1.FederatedGraph{
+		Name:                name,
+		Namespace:           namespace,
+		RoutingURL:          routingUrl,
+		AdmissionWebhookUrl: admissionWebhookUrl,
+		Readme:              readme,
+	})
+	if apiError != nil && !isCompositionFailedError(apiError) {
+		return nil, apiError
+	}
+
+	if contractError != nil {
+		return nil, contractError
+	}
+
 	if apiError != nil {
 		return nil, apiError
 	}
@@ -61,6 +83,10 @@ func (p *PlatformClient) UpdateContract(ctx context.Context, name, namespace str
 	return response.Msg, nil
 }
 
+func isCompositionFailedError(apiError *ApiError) bool {
+	return IsContractCompositionFailedError(apiError) || IsSubgraphCompositionFailedError(apiError)
+}
+
 func (p *PlatformClient) DeleteContract(ctx context.Context, name, namespace string) *ApiError {
 	return p.DeleteFederatedGraph(ctx, name, namespace)
 }
@@ -0,0 +1,98 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/common"
+	platformv1 "github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/platform/v1"
+)
+
+// deploymentLookback bounds the compositions considered when waiting for a deployment, a composition older
+// than that has long been deployed.
+const deploymentLookback = 7 * 24 * time.Hour
+
+func (p PlatformClient) GetFederatedGraphsBySubgraphLabels(ctx context.Context, subgraphName, namespace string) ([]*platformv1.FederatedGraph, *ApiError) {
+	request := connect.NewRequest(&platformv1.GetFederatedGraphsBySubgraphLabelsRequest{
+		SubgraphName: subgraphName,
+		Namespace:    namespace,
+	})
+	response, err := p.Client.GetFederatedGraphsBySubgraphLabels(ctx, request)
+	if err != nil {
+		return nil, &ApiError{Err: err, Reason: "GetFederatedGraphsBySubgraphLabels", Status: common.EnumStatusCode_ERR}
+	}
+
+	if response.Msg == nil {
+		return nil, &ApiError{Err: ErrEmptyMsg, Reason: "GetFederatedGraphsBySubgraphLabels", Status: common.EnumStatusCode_ERR}
+	}
+
+	apiError := handleErrorCodes(response.Msg.GetResponse().Code, response.Msg.String())
+	if apiError != nil {
+		return nil, apiError
+	}
+
+	return response.Msg.GetGraphs(), nil
+}
+
+// WaitForDeployment polls the latest composition of a federated graph until the cdn serves its router config,
+// or the context is done. It fails early when the latest composition cannot be deployed. A single router token
+// is generated per wait and reused by every poll.
+func (p PlatformClient) WaitForDeployment(ctx context.Context, fedGraphName, namespace string, pollInterval time.Duration) *ApiError {
+	token, apiError := p.GenerateRouterToken(ctx, fedGraphName, namespace)
+	if apiError != nil {
+		return apiError
+	}
+
+	for {
+		deployed, apiError := p.isLatestCompositionDeployed(ctx, fedGraphName, namespace, token)
+		if apiError != nil {
+			return apiError
+		}
+
+		if deployed {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return &ApiError{Err: ctx.Err(), Reason: fmt.Sprintf("timed out waiting for the deployment of graph '%s' in namespace '%s'", fedGraphName, namespace), Status: common.EnumStatusCode_ERR}
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+func (p PlatformClient) isLatestCompositionDeployed(ctx context.Context, fedGraphName, namespace, token string) (bool, *ApiError) {
+	now := time.Now().UTC()
+	compositions, apiError := p.GetCompositions(ctx, fedGraphName, namespace, now.Add(-deploymentLookback).Format(time.RFC3339), now.Format(time.RFC3339), 1, 0)
+	if apiError != nil {
+		return false, apiError
+	}
+
+	if len(compositions) == 0 {
+		return true, nil
+	}
+
+	latest := compositions[0]
+	switch {
+	case !latest.GetIsComposable():
+		return false, &ApiError{Err: ErrSubgraphCompositionFailed, Reason: fmt.Sprintf("the latest composition of graph '%s' in namespace '%s' failed and will not be deployed", fedGraphName, namespace), Status: common.EnumStatusCode_ERR_SUBGRAPH_COMPOSITION_FAILED}
+	case latest.GetAdmissionError() != "":
+		return false, &ApiError{Err: ErrGeneral, Reason: fmt.Sprintf("the latest composition of graph '%s' in namespace '%s' was rejected by the admission webhook: %s", fedGraphName, namespace, latest.GetAdmissionError()), Status: common.EnumStatusCode_ERR_DEPLOYMENT_FAILED}
+	case latest.GetDeploymentError() != "":
+		return false, &ApiError{Err: ErrGeneral, Reason: fmt.Sprintf("the deployment of the latest composition of graph '%s' in namespace '%s' failed: %s", fedGraphName, namespace, latest.GetDeploymentError()), Status: common.EnumStatusCode_ERR_DEPLOYMENT_FAILED}
+	case !latest.GetIsLatestValid():
+		return false, nil
+	}
+
+	routerConfig, apiError := p.fetchLatestValidRouterConfig(ctx, fedGraphName, namespace, token)
+	if apiError != nil {
+		if IsNotFoundError(apiError) {
+			return false, nil
+		}
+		return false, apiError
+	}
+
+	return routerConfig.VersionId == latest.GetSchemaVersionId(), nil
+}
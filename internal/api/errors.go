@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	common "github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/common"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/utils"
 )
 
 var (
@@ -42,12 +43,21 @@ type ApiError struct {
 	Err    error
 	Reason string
 	Status common.EnumStatusCode
+	Report *CompositionReport
 }
 
 func (e *ApiError) Error() string {
 	return fmt.Sprintf("%s: %s (status: %s)", e.Err.Error(), e.Reason, e.Status.String())
 }
 
+// CompositionIssues returns the composition and deployment errors reported with the error, if any.
+func (e *ApiError) CompositionIssues() []utils.CompositionIssue {
+	if e.Report == nil {
+		return nil
+	}
+	return e.Report.Issues()
+}
+
 func NewApiErrorWithErr(statusCode common.EnumStatusCode, reason string, err error) *ApiError {
 	return &ApiError{Err: err, Reason: reason, Status: statusCode}
 }
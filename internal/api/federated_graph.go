@@ -35,7 +35,7 @@ func (p *PlatformClient) CreateFederatedGraph(ctx context.Context, admissionWebh
 		return nil, &ApiError{Err: ErrEmptyMsg, Reason: "CreateFederatedGraph", Status: common.EnumStatusCode_ERR}
 	}
 
-	apiError := handleErrorCodes(response.Msg.GetResponse().Code, response.Msg.String())
+	apiError := handleCompositionErrorCodes(response.Msg.GetResponse(), response.Msg.GetCompositionErrors(), response.Msg.GetDeploymentErrors(), response.Msg.String())
 	if apiError != nil {
 		return nil, apiError
 	}
@@ -68,7 +68,7 @@ func (p *PlatformClient) UpdateFederatedGraph(ctx context.Context, admissionWebh
 		return nil, &ApiError{Err: ErrEmptyMsg, Reason: "UpdateFederatedGraph", Status: common.EnumStatusCode_ERR}
 	}
 
-	apiError := handleErrorCodes(response.Msg.GetResponse().Code, response.Msg.String())
+	apiError := handleCompositionErrorCodes(response.Msg.GetResponse(), response.Msg.GetCompositionErrors(), response.Msg.GetDeploymentErrors(), response.Msg.String())
 	if apiError != nil {
 		return nil, apiError
 	}
@@ -121,3 +121,48 @@ func (p *PlatformClient) GetFederatedGraph(ctx context.Context, name, namespace
 
 	return response.Msg, nil
 }
+
+func (p *PlatformClient) GetFederatedGraphSDL(ctx context.Context, name, namespace string) (*platformv1.GetFederatedGraphSDLByNameResponse, *ApiError) {
+	request := connect.NewRequest(&platformv1.GetFederatedGraphSDLByNameRequest{
+		Name:      name,
+		Namespace: namespace,
+	})
+
+	response, err := p.Client.GetFederatedGraphSDLByName(ctx, request)
+	if err != nil {
+		return nil, &ApiError{Err: err, Reason: "GetFederatedGraphSDL", Status: common.EnumStatusCode_ERR}
+	}
+
+	if response.Msg == nil {
+		return nil, &ApiError{Err: ErrEmptyMsg, Reason: "GetFederatedGraphSDL", Status: common.EnumStatusCode_ERR}
+	}
+
+	apiError := handleErrorCodes(response.Msg.GetResponse().Code, response.Msg.String())
+	if apiError != nil {
+		return nil, apiError
+	}
+
+	return response.Msg, nil
+}
+
+func (p *PlatformClient) GetFederatedGraphs(ctx context.Context, namespace string) ([]*platformv1.FederatedGraph, *ApiError) {
+	request := connect.NewRequest(&platformv1.GetFederatedGraphsRequest{
+		Namespace: namespace,
+	})
+
+	response, err := p.Client.GetFederatedGraphs(ctx, request)
+	if err != nil {
+		return nil, &ApiError{Err: err, Reason: "GetFederatedGraphs", Status: common.EnumStatusCode_ERR}
+	}
+
+	if response.Msg == nil {
+		return nil, &ApiError{Err: ErrEmptyMsg, Reason: "GetFederatedGraphs", Status: common.EnumStatusCode_ERR}
+	}
+
+	apiError := handleErrorCodes(response.Msg.GetResponse().Code, response.Msg.String())
+	if apiError != nil {
+		return nil, apiError
+	}
+
+	return response.Msg.GetGraphs(), nil
+}
@@ -8,7 +8,13 @@ import (
 	platformv1 "github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/platform/v1"
 )
 
-func (p PlatformClient) CreateMonograph(ctx context.Context, name string, namespace string, routingUrl string, graphUrl string, subscriptionUrl *string, readme *string, websocketSubprotocol string, subscriptionProtocol string, admissionWebhookUrl string, admissionWebhookSecret string) (*platformv1.CreateMonographResponse, *ApiError) {
+// CreateMonograph creates a monograph, optional fields passed as nil are left to the platform defaults.
+func (p PlatformClient) CreateMonograph(ctx context.Context, name string, namespace string, routingUrl string, graphUrl string, subscriptionUrl *string, readme *string, websocketSubprotocol *string, subscriptionProtocol *string, admissionWebhookUrl *string, admissionWebhookSecret *string) (*platformv1.CreateMonographResponse, *ApiError) {
+	var webhookUrl string
+	if admissionWebhookUrl != nil {
+		webhookUrl = *admissionWebhookUrl
+	}
+
 	request := connect.NewRequest(&platformv1.CreateMonographRequest{
 		Name:                   name,
 		Namespace:              namespace,
@@ -16,10 +22,10 @@ func (p PlatformClient) CreateMonograph(ctx context.Context, name string, namesp
 		GraphUrl:               graphUrl,
 		SubscriptionUrl:        subscriptionUrl,
 		Readme:                 readme,
-		WebsocketSubprotocol:   resolveWebsocketSubprotocol(websocketSubprotocol),
-		SubscriptionProtocol:   resolveSubscriptionProtocol(subscriptionProtocol),
-		AdmissionWebhookURL:    admissionWebhookUrl,
-		AdmissionWebhookSecret: &admissionWebhookSecret,
+		WebsocketSubprotocol:   resolveOptionalWebsocketSubprotocol(websocketSubprotocol),
+		SubscriptionProtocol:   resolveOptionalSubscriptionProtocol(subscriptionProtocol),
+		AdmissionWebhookURL:    webhookUrl,
+		AdmissionWebhookSecret: admissionWebhookSecret,
 	})
 	response, err := p.Client.CreateMonograph(ctx, request)
 	if err != nil {
@@ -38,7 +44,9 @@ func (p PlatformClient) CreateMonograph(ctx context.Context, name string, namesp
 	return response.Msg, nil
 }
 
-func (p PlatformClient) UpdateMonograph(ctx context.Context, name string, namespace string, routingUrl string, graphUrl string, subscriptionUrl *string, readme *string, websocketSubprotocol string, subscriptionProtocol string, admissionWebhookUrl string, admissionWebhookSecret string) *ApiError {
+// UpdateMonograph updates a monograph. Optional fields passed as nil are left untouched on the platform,
+// an empty string clears them.
+func (p PlatformClient) UpdateMonograph(ctx context.Context, name string, namespace string, routingUrl string, graphUrl string, subscriptionUrl *string, readme *string, websocketSubprotocol *string, subscriptionProtocol *string, admissionWebhookUrl *string, admissionWebhookSecret *string) *ApiError {
 	request := connect.NewRequest(&platformv1.UpdateMonographRequest{
 		Name:                   name,
 		Namespace:              namespace,
@@ -46,10 +54,10 @@ func (p PlatformClient) UpdateMonograph(ctx context.Context, name string, namesp
 		GraphUrl:               graphUrl,
 		SubscriptionUrl:        subscriptionUrl,
 		Readme:                 readme,
-		WebsocketSubprotocol:   resolveWebsocketSubprotocol(websocketSubprotocol),
-		SubscriptionProtocol:   resolveSubscriptionProtocol(subscriptionProtocol),
-		AdmissionWebhookURL:    &admissionWebhookUrl,
-		AdmissionWebhookSecret: &admissionWebhookSecret,
+		WebsocketSubprotocol:   resolveOptionalWebsocketSubprotocol(websocketSubprotocol),
+		SubscriptionProtocol:   resolveOptionalSubscriptionProtocol(subscriptionProtocol),
+		AdmissionWebhookURL:    admissionWebhookUrl,
+		AdmissionWebhookSecret: admissionWebhookSecret,
 	})
 	response, err := p.Client.UpdateMonograph(ctx, request)
 	if err != nil {
@@ -111,3 +119,26 @@ func (p PlatformClient) GetMonograph(ctx context.Context, name string, namespace
 
 	return response.Msg.Graph, nil
 }
+
+func (p PlatformClient) PublishMonograph(ctx context.Context, name, namespace, schema string) (*platformv1.PublishMonographResponse, *ApiError) {
+	request := connect.NewRequest(&platformv1.PublishMonographRequest{
+		Name:      name,
+		Namespace: namespace,
+		Schema:    schema,
+	})
+	response, err := p.Client.PublishMonograph(ctx, request)
+	if err != nil {
+		return nil, &ApiError{Err: err, Reason: "PublishMonograph", Status: common.EnumStatusCode_ERR}
+	}
+
+	if response.Msg == nil {
+		return nil, &ApiError{Err: ErrEmptyMsg, Reason: "PublishMonograph", Status: common.EnumStatusCode_ERR}
+	}
+
+	apiError := handleCompositionErrorCodes(response.Msg.GetResponse(), response.Msg.GetCompositionErrors(), response.Msg.GetDeploymentErrors(), response.Msg.String())
+	if apiError != nil {
+		return nil, apiError
+	}
+
+	return response.Msg, nil
+}
@@ -0,0 +1,78 @@
+package api
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/common"
+	platformv1 "github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/platform/v1"
+)
+
+const (
+	OrganizationRoleAdmin     = "admin"
+	OrganizationRoleDeveloper = "developer"
+	OrganizationRoleViewer    = "viewer"
+)
+
+func (p PlatformClient) CreateOIDCProvider(ctx context.Context, name, discoveryEndpoint, clientId, clientSecret string, mappers []*platformv1.GroupMapper) (*platformv1.CreateOIDCProviderResponse, *ApiError) {
+	request := connect.NewRequest(&platformv1.CreateOIDCProviderRequest{
+		Name:              name,
+		DiscoveryEndpoint: discoveryEndpoint,
+		ClientID:          clientId,
+		ClientSecrect:     clientSecret,
+		Mappers:           mappers,
+	})
+	response, err := p.Client.CreateOIDCProvider(ctx, request)
+	if err != nil {
+		return nil, &ApiError{Err: err, Reason: "CreateOIDCProvider", Status: common.EnumStatusCode_ERR}
+	}
+
+	if response.Msg == nil {
+		return nil, &ApiError{Err: ErrEmptyMsg, Reason: "CreateOIDCProvider", Status: common.EnumStatusCode_ERR}
+	}
+
+	apiError := handleErrorCodes(response.Msg.GetResponse().Code, response.Msg.String())
+	if apiError != nil {
+		return nil, apiError
+	}
+
+	return response.Msg, nil
+}
+
+func (p PlatformClient) GetOIDCProvider(ctx context.Context) (*platformv1.GetOIDCProviderResponse, *ApiError) {
+	request := connect.NewRequest(&platformv1.GetOIDCProviderRequest{})
+	response, err := p.Client.GetOIDCProvider(ctx, request)
+	if err != nil {
+		return nil, &ApiError{Err: err, Reason: "GetOIDCProvider", Status: common.EnumStatusCode_ERR}
+	}
+
+	if response.Msg == nil {
+		return nil, &ApiError{Err: ErrEmptyMsg, Reason: "GetOIDCProvider", Status: common.EnumStatusCode_ERR}
+	}
+
+	apiError := handleErrorCodes(response.Msg.GetResponse().Code, response.Msg.String())
+	if apiError != nil {
+		return nil, apiError
+	}
+
+	return response.Msg, nil
+}
+
+func (p PlatformClient) DeleteOIDCProvider(ctx context.Context) *ApiError {
+	request := connect.NewRequest(&platformv1.DeleteOIDCProviderRequest{})
+	response, err := p.Client.DeleteOIDCProvider(ctx, request)
+	if err != nil {
+		return &ApiError{Err: err, Reason: "DeleteOIDCProvider", Status: common.EnumStatusCode_ERR}
+	}
+
+	if response.Msg == nil {
+		return &ApiError{Err: ErrEmptyMsg, Reason: "DeleteOIDCProvider", Status: common.EnumStatusCode_ERR}
+	}
+
+	apiError := handleErrorCodes(response.Msg.GetResponse().Code, response.Msg.String())
+	if apiError != nil {
+		return apiError
+	}
+
+	return nil
+}
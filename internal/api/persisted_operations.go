@@ -0,0 +1,96 @@
+package api
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/common"
+	platformv1 "github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/platform/v1"
+)
+
+const (
+	PublishedOperationStatusCreated  = "created"
+	PublishedOperationStatusUpToDate = "up_to_date"
+	PublishedOperationStatusConflict = "conflict"
+)
+
+func ResolvePublishedOperationStatus(status platformv1.PublishedOperationStatus) string {
+	switch status {
+	case platformv1.PublishedOperationStatus_CREATED:
+		return PublishedOperationStatusCreated
+	case platformv1.PublishedOperationStatus_CONFLICT:
+		return PublishedOperationStatusConflict
+	// platformv1.PublishedOperationStatus_UP_TO_DATE
+	default:
+		return PublishedOperationStatusUpToDate
+	}
+}
+
+func (p PlatformClient) PublishPersistedOperations(ctx context.Context, fedGraphName, namespace, clientName string, operations []*platformv1.PersistedOperation) ([]*platformv1.PublishedOperation, *ApiError) {
+	request := connect.NewRequest(&platformv1.PublishPersistedOperationsRequest{
+		FedGraphName: fedGraphName,
+		Namespace:    namespace,
+		ClientName:   clientName,
+		Operations:   operations,
+	})
+	response, err := p.Client.PublishPersistedOperations(ctx, request)
+	if err != nil {
+		return nil, &ApiError{Err: err, Reason: "PublishPersistedOperations", Status: common.EnumStatusCode_ERR}
+	}
+
+	if response.Msg == nil {
+		return nil, &ApiError{Err: ErrEmptyMsg, Reason: "PublishPersistedOperations", Status: common.EnumStatusCode_ERR}
+	}
+
+	apiError := handleErrorCodes(response.Msg.GetResponse().Code, response.Msg.String())
+	if apiError != nil {
+		return nil, apiError
+	}
+
+	return response.Msg.GetOperations(), nil
+}
+
+func (p PlatformClient) GetPersistedOperations(ctx context.Context, fedGraphName, namespace, clientId string) ([]*platformv1.GetPersistedOperationsResponse_Operation, *ApiError) {
+	request := connect.NewRequest(&platformv1.GetPersistedOperationsRequest{
+		FederatedGraphName: fedGraphName,
+		Namespace:          namespace,
+		ClientId:           clientId,
+	})
+	response, err := p.Client.GetPersistedOperations(ctx, request)
+	if err != nil {
+		return nil, &ApiError{Err: err, Reason: "GetPersistedOperations", Status: common.EnumStatusCode_ERR}
+	}
+
+	if response.Msg == nil {
+		return nil, &ApiError{Err: ErrEmptyMsg, Reason: "GetPersistedOperations", Status: common.EnumStatusCode_ERR}
+	}
+
+	apiError := handleErrorCodes(response.Msg.GetResponse().Code, response.Msg.String())
+	if apiError != nil {
+		return nil, apiError
+	}
+
+	return response.Msg.GetOperations(), nil
+}
+
+func (p PlatformClient) GetClients(ctx context.Context, fedGraphName, namespace string) ([]*platformv1.ClientInfo, *ApiError) {
+	request := connect.NewRequest(&platformv1.GetClientsRequest{
+		FedGraphName: fedGraphName,
+		Namespace:    namespace,
+	})
+	response, err := p.Client.GetClients(ctx, request)
+	if err != nil {
+		return nil, &ApiError{Err: err, Reason: "GetClients", Status: common.EnumStatusCode_ERR}
+	}
+
+	if response.Msg == nil {
+		return nil, &ApiError{Err: ErrEmptyMsg, Reason: "GetClients", Status: common.EnumStatusCode_ERR}
+	}
+
+	apiError := handleErrorCodes(response.Msg.GetResponse().Code, response.Msg.String())
+	if apiError != nil {
+		return nil, apiError
+	}
+
+	return response.Msg.GetClients(), nil
+}
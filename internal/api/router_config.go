@@ -0,0 +1,174 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/common"
+	platformv1 "github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/platform/v1"
+)
+
+// RouterConfig is the latest valid router execution config of a federated graph or contract.
+type RouterConfig struct {
+	Config        string
+	VersionId     string
+	CompositionId string
+}
+
+// compositionLookupPageSize is the number of compositions fetched at once when looking up the composition
+// of a router config.
+const compositionLookupPageSize = 50
+
+type graphTokenClaims struct {
+	OrganizationId   string `json:"organization_id"`
+	FederatedGraphId string `json:"federated_graph_id"`
+}
+
+func (p PlatformClient) GenerateRouterToken(ctx context.Context, fedGraphName, namespace string) (string, *ApiError) {
+	request := connect.NewRequest(&platformv1.GenerateRouterTokenRequest{
+		FedGraphName: fedGraphName,
+		Namespace:    namespace,
+	})
+	response, err := p.Client.GenerateRouterToken(ctx, request)
+	if err != nil {
+		return "", &ApiError{Err: err, Reason: "GenerateRouterToken", Status: common.EnumStatusCode_ERR}
+	}
+
+	if response.Msg == nil {
+		return "", &ApiError{Err: ErrEmptyMsg, Reason: "GenerateRouterToken", Status: common.EnumStatusCode_ERR}
+	}
+
+	apiError := handleErrorCodes(response.Msg.GetResponse().Code, response.Msg.String())
+	if apiError != nil {
+		return "", apiError
+	}
+
+	return response.Msg.GetToken(), nil
+}
+
+// GetLatestValidRouterConfig fetches the router config of the latest valid composition from the cdn,
+// authenticated with a short-lived graph token, the same way the router and `wgc router fetch` do, along
+// with the identifier of the composition it was built from.
+func (p PlatformClient) GetLatestValidRouterConfig(ctx context.Context, fedGraphName, namespace string) (*RouterConfig, *ApiError) {
+	token, apiError := p.GenerateRouterToken(ctx, fedGraphName, namespace)
+	if apiError != nil {
+		return nil, apiError
+	}
+
+	routerConfig, apiError := p.fetchLatestValidRouterConfig(ctx, fedGraphName, namespace, token)
+	if apiError != nil {
+		return nil, apiError
+	}
+
+	routerConfig.CompositionId, apiError = p.compositionIdOfSchemaVersion(ctx, fedGraphName, namespace, routerConfig.VersionId)
+	if apiError != nil {
+		return nil, apiError
+	}
+
+	return routerConfig, nil
+}
+
+// fetchLatestValidRouterConfig fetches the router config of the latest valid composition from the cdn with a
+// router token of the graph, without looking up the composition it was built from.
+func (p PlatformClient) fetchLatestValidRouterConfig(ctx context.Context, fedGraphName, namespace, token string) (*RouterConfig, *ApiError) {
+	claims, err := parseGraphTokenClaims(token)
+	if err != nil {
+		return nil, &ApiError{Err: err, Reason: "GetLatestValidRouterConfig", Status: common.EnumStatusCode_ERR}
+	}
+
+	url := fmt.Sprintf("%s/%s/%s/routerconfigs/latest.json", strings.TrimSuffix(p.cosmoCdnUrl, "/"), claims.OrganizationId, claims.FederatedGraphId)
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBufferString(`{"version":""}`))
+	if err != nil {
+		return nil, &ApiError{Err: err, Reason: "GetLatestValidRouterConfig", Status: common.EnumStatusCode_ERR}
+	}
+	request.Header.Set("Authorization", "Bearer "+token)
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := p.cdnClient.Do(request)
+	if err != nil {
+		return nil, &ApiError{Err: err, Reason: "GetLatestValidRouterConfig", Status: common.EnumStatusCode_ERR}
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, &ApiError{Err: err, Reason: "GetLatestValidRouterConfig", Status: common.EnumStatusCode_ERR}
+	}
+
+	switch response.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, &ApiError{Err: ErrNotFound, Reason: fmt.Sprintf("no valid router config found for graph '%s' in namespace '%s'", fedGraphName, namespace), Status: common.EnumStatusCode_ERR_NOT_FOUND}
+	default:
+		return nil, &ApiError{Err: ErrGeneral, Reason: fmt.Sprintf("fetching router config failed with status %d: %s", response.StatusCode, string(body)), Status: common.EnumStatusCode_ERR}
+	}
+
+	var config struct {
+		Version string `json:"version"`
+	}
+	if err := json.Unmarshal(body, &config); err != nil {
+		return nil, &ApiError{Err: err, Reason: "GetLatestValidRouterConfig", Status: common.EnumStatusCode_ERR}
+	}
+
+	return &RouterConfig{
+		Config:    string(body),
+		VersionId: config.Version,
+	}, nil
+}
+
+// compositionIdOfSchemaVersion looks up the composition that produced the schema version among the recent
+// compositions of the graph, the version of a router config is the schema version of its composition. It
+// returns an empty identifier when the composition is older than the lookback.
+func (p PlatformClient) compositionIdOfSchemaVersion(ctx context.Context, fedGraphName, namespace, schemaVersionId string) (string, *ApiError) {
+	now := time.Now().UTC()
+	startDate, endDate := now.Add(-deploymentLookback).Format(time.RFC3339), now.Format(time.RFC3339)
+
+	for offset := int32(0); ; offset += compositionLookupPageSize {
+		compositions, apiError := p.GetCompositions(ctx, fedGraphName, namespace, startDate, endDate, compositionLookupPageSize, offset)
+		if apiError != nil {
+			return "", apiError
+		}
+
+		for _, composition := range compositions {
+			if composition.GetSchemaVersionId() == schemaVersionId {
+				return composition.GetId(), nil
+			}
+		}
+
+		if len(compositions) < compositionLookupPageSize {
+			return "", nil
+		}
+	}
+}
+
+// parseGraphTokenClaims reads the claims of a graph token without verifying it, the cdn does the verification.
+func parseGraphTokenClaims(token string) (*graphTokenClaims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("invalid graph token")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("invalid graph token payload: %w", err)
+	}
+
+	var claims graphTokenClaims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, fmt.Errorf("invalid graph token claims: %w", err)
+	}
+
+	if claims.OrganizationId == "" || claims.FederatedGraphId == "" {
+		return nil, fmt.Errorf("graph token is missing the organization or federated graph id")
+	}
+
+	return &claims, nil
+}
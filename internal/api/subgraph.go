@@ -62,7 +62,7 @@ func (p PlatformClient) UpdateSubgraph(ctx context.Context, name, namespace, rou
 		return &ApiError{Err: ErrEmptyMsg, Reason: "UpdateSubgraph", Status: common.EnumStatusCode_ERR}
 	}
 
-	apiError := handleErrorCodes(response.Msg.GetResponse().Code, response.Msg.String())
+	apiError := handleCompositionErrorCodes(response.Msg.GetResponse(), response.Msg.GetCompositionErrors(), response.Msg.GetDeploymentErrors(), response.Msg.String())
 	if apiError != nil {
 		return apiError
 	}
@@ -114,6 +114,30 @@ func (p PlatformClient) GetSubgraph(ctx context.Context, name, namespace string)
 	return response.Msg.GetGraph(), nil
 }
 
+// GetLatestSubgraphSDL returns the latest published schema of a subgraph, monographs are backed by a
+// subgraph of the same name.
+func (p PlatformClient) GetLatestSubgraphSDL(ctx context.Context, name, namespace string) (*platformv1.GetLatestSubgraphSDLResponse, *ApiError) {
+	request := connect.NewRequest(&platformv1.GetLatestSubgraphSDLRequest{
+		Name:      name,
+		Namespace: namespace,
+	})
+	response, err := p.Client.GetLatestSubgraphSDL(ctx, request)
+	if err != nil {
+		return nil, &ApiError{Err: err, Reason: "GetLatestSubgraphSDL", Status: common.EnumStatusCode_ERR}
+	}
+
+	if response.Msg == nil {
+		return nil, &ApiError{Err: ErrEmptyMsg, Reason: "GetLatestSubgraphSDL", Status: common.EnumStatusCode_ERR}
+	}
+
+	apiError := handleErrorCodes(response.Msg.GetResponse().Code, response.Msg.String())
+	if apiError != nil {
+		return nil, apiError
+	}
+
+	return response.Msg, nil
+}
+
 func (p PlatformClient) PublishSubgraph(ctx context.Context, name, namespace, schema string) (*platformv1.PublishFederatedSubgraphResponse, *ApiError) {
 	request := connect.NewRequest(&platformv1.PublishFederatedSubgraphRequest{
 		Name:      name,
@@ -129,7 +153,7 @@ func (p PlatformClient) PublishSubgraph(ctx context.Context, name, namespace, sc
 		return nil, &ApiError{Err: ErrEmptyMsg, Reason: "PublishSubgraph", Status: common.EnumStatusCode_ERR}
 	}
 
-	apiError := handleErrorCodes(response.Msg.GetResponse().Code, response.Msg.String())
+	apiError := handleCompositionErrorCodes(response.Msg.GetResponse(), response.Msg.GetCompositionErrors(), response.Msg.GetDeploymentErrors(), response.Msg.String())
 	if apiError != nil {
 		return nil, apiError
 	}
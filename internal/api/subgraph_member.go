@@ -0,0 +1,77 @@
+package api
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/common"
+	platformv1 "github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/platform/v1"
+)
+
+func (p PlatformClient) AddSubgraphMember(ctx context.Context, subgraphName, namespace, userEmail string) *ApiError {
+	request := connect.NewRequest(&platformv1.AddSubgraphMemberRequest{
+		SubgraphName: subgraphName,
+		Namespace:    namespace,
+		UserEmail:    userEmail,
+	})
+	response, err := p.Client.AddSubgraphMember(ctx, request)
+	if err != nil {
+		return &ApiError{Err: err, Reason: "AddSubgraphMember", Status: common.EnumStatusCode_ERR}
+	}
+
+	if response.Msg == nil {
+		return &ApiError{Err: ErrEmptyMsg, Reason: "AddSubgraphMember", Status: common.EnumStatusCode_ERR}
+	}
+
+	apiError := handleErrorCodes(response.Msg.GetResponse().Code, response.Msg.String())
+	if apiError != nil {
+		return apiError
+	}
+
+	return nil
+}
+
+func (p PlatformClient) RemoveSubgraphMember(ctx context.Context, subgraphName, namespace, subgraphMemberId string) *ApiError {
+	request := connect.NewRequest(&platformv1.RemoveSubgraphMemberRequest{
+		SubgraphName:     subgraphName,
+		Namespace:        namespace,
+		SubgraphMemberId: subgraphMemberId,
+	})
+	response, err := p.Client.RemoveSubgraphMember(ctx, request)
+	if err != nil {
+		return &ApiError{Err: err, Reason: "RemoveSubgraphMember", Status: common.EnumStatusCode_ERR}
+	}
+
+	if response.Msg == nil {
+		return &ApiError{Err: ErrEmptyMsg, Reason: "RemoveSubgraphMember", Status: common.EnumStatusCode_ERR}
+	}
+
+	apiError := handleErrorCodes(response.Msg.GetResponse().Code, response.Msg.String())
+	if apiError != nil {
+		return apiError
+	}
+
+	return nil
+}
+
+func (p PlatformClient) GetSubgraphMembers(ctx context.Context, subgraphName, namespace string) ([]*platformv1.SubgraphMember, *ApiError) {
+	request := connect.NewRequest(&platformv1.GetSubgraphMembersRequest{
+		SubgraphName: subgraphName,
+		Namespace:    namespace,
+	})
+	response, err := p.Client.GetSubgraphMembers(ctx, request)
+	if err != nil {
+		return nil, &ApiError{Err: err, Reason: "GetSubgraphMembers", Status: common.EnumStatusCode_ERR}
+	}
+
+	if response.Msg == nil {
+		return nil, &ApiError{Err: ErrEmptyMsg, Reason: "GetSubgraphMembers", Status: common.EnumStatusCode_ERR}
+	}
+
+	apiError := handleErrorCodes(response.Msg.GetResponse().Code, response.Msg.String())
+	if apiError != nil {
+		return nil, apiError
+	}
+
+	return response.Msg.GetMembers(), nil
+}
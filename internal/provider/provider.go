@@ -4,21 +4,28 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/api"
 	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/utils"
 
+	composition "github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/service/composition"
 	contract "github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/service/contract"
 	federated_graph "github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/service/federated-graph"
 	monograph "github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/service/monograph"
 	namespace "github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/service/namespace"
+	oidc_provider "github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/service/oidc-provider"
+	persisted_operations "github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/service/persisted-operations"
+	router_config "github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/service/router-config"
 	router_token "github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/service/router-token"
 	subgraph "github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/service/subgraph"
+	subgraph_member "github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/service/subgraph-member"
 )
 
 // Ensure CosmoProvider satisfies various provider interfaces.
@@ -41,6 +48,9 @@ type Provider struct {
 type CosmoProviderModel struct {
 	ApiUrl types.String `tfsdk:"api_url"`
 	ApiKey types.String `tfsdk:"api_key"`
+	CdnUrl types.String `tfsdk:"cdn_url"`
+
+	CompositionFailureMode types.String `tfsdk:"composition_failure_mode"`
 }
 
 func (p *CosmoProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -65,6 +75,17 @@ Refer to the official [Cosmo Documentation](https://cosmo-docs.wundergraph.com/)
 				MarkdownDescription: fmt.Sprintf("The Api Key to be used: Leave blank to use the %s environment variable", utils.EnvCosmoApiKey),
 				Optional:            true,
 			},
+			"cdn_url": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("The Cdn Url used to fetch router configurations: Leave blank to use: %s or use the %s environment variable", api.DefaultCdnUrl, utils.EnvCosmoCdnUrl),
+				Optional:            true,
+			},
+			"composition_failure_mode": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("How resources handle a failed composition, unless overridden on the resource: `%s` reports it as a warning, `%s` fails the apply and `%s` additionally republishes the previously stored schema. Defaults to `%s`.", api.CompositionFailureModeWarn, api.CompositionFailureModeError, api.CompositionFailureModeRollback, api.CompositionFailureModeWarn),
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(api.CompositionFailureModeWarn, api.CompositionFailureModeError, api.CompositionFailureModeRollback),
+				},
+			},
 		},
 	}
 }
@@ -80,13 +101,16 @@ func (p *CosmoProvider) Configure(ctx context.Context, req provider.ConfigureReq
 
 	cosmoApiKey := data.ApiKey.ValueString()
 	cosmoApiUrl := data.ApiUrl.ValueString()
+	cosmoCdnUrl := data.CdnUrl.ValueString()
 
-	platformClient, err := api.NewClient(cosmoApiKey, cosmoApiUrl)
+	platformClient, err := api.NewClient(cosmoApiKey, cosmoApiUrl, cosmoCdnUrl)
 
 	if err != nil {
 		utils.AddDiagnosticError(resp, "Error configuring client", err.Error())
 		return
 	}
+
+	platformClient.CompositionFailureMode = data.CompositionFailureMode.ValueString()
 	resp.DataSourceData = platformClient
 	resp.ResourceData = platformClient
 }
@@ -99,16 +123,23 @@ func (p *CosmoProvider) Resources(ctx context.Context) []func() resource.Resourc
 		monograph.NewMonographResource,
 		router_token.NewTokenResource,
 		contract.NewContractResource,
+		subgraph_member.NewSubgraphMemberResource,
+		oidc_provider.NewOIDCProviderResource,
+		persisted_operations.NewPersistedOperationsResource,
 	}
 }
 
 func (p *CosmoProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		federated_graph.NewFederatedGraphDataSource,
+		federated_graph.NewFederatedGraphSDLDataSource,
 		subgraph.NewSubgraphDataSource,
 		namespace.NewNamespaceDataSource,
 		monograph.NewMonographDataSource,
 		contract.NewContractDataSource,
+		router_config.NewRouterConfigDataSource,
+		composition.NewCompositionsDataSource,
+		composition.NewCompositionPreviewDataSource,
 	}
 }
 
@@ -0,0 +1,264 @@
+package composition
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/api"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CompositionPreviewDataSource{}
+
+func NewCompositionPreviewDataSource() datasource.DataSource {
+	return &CompositionPreviewDataSource{}
+}
+
+// CompositionPreviewDataSource defines the data source implementation.
+type CompositionPreviewDataSource struct {
+	client *api.PlatformClient
+}
+
+// CompositionPreviewDataSourceModel describes the data source data model.
+type CompositionPreviewDataSourceModel struct {
+	Id                types.String                      `tfsdk:"id"`
+	FederatedGraph    types.String                      `tfsdk:"federated_graph"`
+	Namespace         types.String                      `tfsdk:"namespace"`
+	LabelMatchers     types.List                        `tfsdk:"label_matchers"`
+	Subgraphs         []CompositionPreviewSubgraphModel `tfsdk:"subgraphs"`
+	IsComposable      types.Bool                        `tfsdk:"is_composable"`
+	MatchedSubgraphs  types.List                        `tfsdk:"matched_subgraphs"`
+	CompositionErrors types.List                        `tfsdk:"composition_errors"`
+}
+
+// CompositionPreviewSubgraphModel is a proposed subgraph schema and the outcome of its check.
+type CompositionPreviewSubgraphModel struct {
+	Name              types.String `tfsdk:"name"`
+	Schema            types.String `tfsdk:"schema"`
+	IsComposable      types.Bool   `tfsdk:"is_composable"`
+	CompositionErrors types.List   `tfsdk:"composition_errors"`
+	BreakingChanges   types.List   `tfsdk:"breaking_changes"`
+}
+
+// Metadata returns the data source type name.
+func (d *CompositionPreviewDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_composition_preview"
+}
+
+// Schema defines the schema for the data source.
+func (d *CompositionPreviewDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Cosmo Composition Preview Data Source. Checks each proposed subgraph schema against the published graph, and the label matchers of a federated graph against the published subgraphs, without publishing anything.
+
+Each schema is parsed offline and then checked with the schema check of the platform, which composes it with the **published** schemas of the other subgraphs. The proposed schemas are not composed with each other, the platform has no check for a set of proposed schemas, so changes that only compose together, like renaming a type shared by two subgraphs, are reported as not composable and cannot be gated with this data source.
+		`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the data source, in the format `<namespace>/<federated_graph>`.",
+			},
+			"federated_graph": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the federated graph whose label matchers are checked, it doesn't need to exist yet.",
+			},
+			"namespace": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The namespace of the federated graph and the subgraphs. Defaults to 'default'.",
+			},
+			"label_matchers": schema.ListAttribute{
+				Required:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The label matchers selecting the subgraphs of the federated graph.",
+				Validators: []validator.List{
+					utils.LabelMatchersValidator(),
+				},
+			},
+			"subgraphs": schema.ListNestedAttribute{
+				Required:            true,
+				MarkdownDescription: "The proposed subgraph schemas.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The name of the subgraph.",
+						},
+						"schema": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The proposed schema of the subgraph.",
+						},
+						"is_composable": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the proposed schema composes with the published schemas of the other subgraphs.",
+						},
+						"composition_errors": schema.ListAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "The syntax and composition errors of the proposed schema.",
+						},
+						"breaking_changes": schema.ListAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "The breaking changes of the proposed schema compared to the published one.",
+						},
+					},
+				},
+			},
+			"is_composable": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether every proposed schema composes with the published graph and the published subgraphs matched by the label matchers compose.",
+			},
+			"matched_subgraphs": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The names of the published subgraphs matched by the label matchers.",
+			},
+			"composition_errors": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The composition errors of the subgraphs matched by the label matchers.",
+			},
+		},
+	}
+}
+
+// Configure prepares the data source for reading.
+func (d *CompositionPreviewDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.PlatformClient)
+	if !ok {
+		utils.AddDiagnosticError(resp,
+			ErrUnexpectedDataSourceType,
+			fmt.Sprintf("Expected *api.PlatformClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the data source data.
+func (d *CompositionPreviewDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CompositionPreviewDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.FederatedGraph.IsNull() || data.FederatedGraph.ValueString() == "" {
+		utils.AddDiagnosticError(resp,
+			ErrInvalidGraphName,
+			"The 'federated_graph' attribute is required.",
+		)
+		return
+	}
+
+	namespace := data.Namespace.ValueString()
+	if namespace == "" {
+		namespace = "default"
+	}
+
+	labelMatchers, err := utils.ConvertLabelMatchers(data.LabelMatchers)
+	if err != nil {
+		utils.AddDiagnosticError(resp, ErrPreviewingComposition, err.Error())
+		return
+	}
+
+	isComposable := true
+	for i, subgraph := range data.Subgraphs {
+		compositionErrors, breakingChanges, apiError := d.checkSubgraph(ctx, subgraph.Name.ValueString(), namespace, subgraph.Schema.ValueString())
+		if apiError != nil {
+			utils.AddDiagnosticError(resp,
+				ErrPreviewingComposition,
+				fmt.Sprintf("Could not check the schema of subgraph '%s' in namespace '%s': %s", subgraph.Name.ValueString(), namespace, apiError.Error()),
+			)
+			return
+		}
+
+		data.Subgraphs[i].IsComposable = types.BoolValue(len(compositionErrors) == 0)
+		data.Subgraphs[i].CompositionErrors = stringListValue(compositionErrors)
+		data.Subgraphs[i].BreakingChanges = stringListValue(breakingChanges)
+		isComposable = isComposable && len(compositionErrors) == 0
+	}
+
+	check, apiError := d.client.CheckFederatedGraph(ctx, data.FederatedGraph.ValueString(), namespace, labelMatchers)
+	if apiError != nil {
+		utils.AddDiagnosticError(resp,
+			ErrPreviewingComposition,
+			fmt.Sprintf("Could not check federated graph '%s' in namespace '%s': %s", data.FederatedGraph.ValueString(), namespace, apiError.Error()),
+		)
+		return
+	}
+
+	var matchedSubgraphs []string
+	for _, subgraph := range check.GetSubgraphs() {
+		matchedSubgraphs = append(matchedSubgraphs, subgraph.GetName())
+	}
+
+	var compositionErrors []string
+	for _, compositionError := range check.GetCompositionErrors() {
+		compositionErrors = append(compositionErrors, utils.FormatCompositionIssue(compositionError))
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%s/%s", namespace, data.FederatedGraph.ValueString()))
+	data.Namespace = types.StringValue(namespace)
+	data.IsComposable = types.BoolValue(isComposable && len(compositionErrors) == 0)
+	data.MatchedSubgraphs = stringListValue(matchedSubgraphs)
+	data.CompositionErrors = stringListValue(compositionErrors)
+
+	utils.LogAction(ctx, "read", data.Id.ValueString(), data.FederatedGraph.ValueString(), namespace)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// checkSubgraph parses the proposed schema offline and, when it is valid, checks it on the platform.
+func (d *CompositionPreviewDataSource) checkSubgraph(ctx context.Context, name, namespace, schema string) ([]string, []string, *api.ApiError) {
+	var syntaxErrors []string
+	for _, problem := range utils.ValidateSchema(schema) {
+		if !problem.Warning {
+			syntaxErrors = append(syntaxErrors, problem.String())
+		}
+	}
+	if len(syntaxErrors) > 0 {
+		return syntaxErrors, nil, nil
+	}
+
+	check, apiError := d.client.CheckSubgraphSchema(ctx, name, namespace, schema)
+	if apiError != nil {
+		return nil, nil, apiError
+	}
+
+	var compositionErrors []string
+	for _, compositionError := range check.GetCompositionErrors() {
+		compositionErrors = append(compositionErrors, utils.FormatCompositionIssue(compositionError))
+	}
+
+	var breakingChanges []string
+	for _, change := range check.GetBreakingChanges() {
+		breakingChanges = append(breakingChanges, change.GetMessage())
+	}
+
+	return compositionErrors, breakingChanges, nil
+}
+
+func stringListValue(values []string) types.List {
+	elements := make([]attr.Value, 0, len(values))
+	for _, value := range values {
+		elements = append(elements, types.StringValue(value))
+	}
+	return types.ListValueMust(types.StringType, elements)
+}
@@ -0,0 +1,73 @@
+package composition_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/acceptance"
+)
+
+func TestAccCompositionPreviewDataSource(t *testing.T) {
+	namespace := acctest.RandomWithPrefix("test-namespace")
+	federatedGraphName := acctest.RandomWithPrefix("test-federated-graph")
+	subgraphName := acctest.RandomWithPrefix("test-subgraph")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCompositionPreviewDataSourceConfig(namespace, federatedGraphName, subgraphName, "type Query { hello: String world: String }"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.cosmo_composition_preview.test", "id", fmt.Sprintf("%s/%s", namespace, federatedGraphName)),
+					resource.TestCheckResourceAttr("data.cosmo_composition_preview.test", "is_composable", "true"),
+					resource.TestCheckResourceAttr("data.cosmo_composition_preview.test", "matched_subgraphs.#", "1"),
+					resource.TestCheckResourceAttr("data.cosmo_composition_preview.test", "matched_subgraphs.0", subgraphName),
+					resource.TestCheckResourceAttr("data.cosmo_composition_preview.test", "subgraphs.0.is_composable", "true"),
+					resource.TestCheckResourceAttr("data.cosmo_composition_preview.test", "subgraphs.0.composition_errors.#", "0"),
+				),
+			},
+			{
+				Config: testAccCompositionPreviewDataSourceConfig(namespace, federatedGraphName, subgraphName, "type Query { hello: String"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.cosmo_composition_preview.test", "is_composable", "false"),
+					resource.TestCheckResourceAttr("data.cosmo_composition_preview.test", "subgraphs.0.is_composable", "false"),
+					resource.TestCheckResourceAttr("data.cosmo_composition_preview.test", "subgraphs.0.composition_errors.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCompositionPreviewDataSourceConfig(namespace, federatedGraphName, subgraphName, schema string) string {
+	return fmt.Sprintf(`
+resource "cosmo_namespace" "test" {
+  name = "%s"
+}
+
+resource "cosmo_subgraph" "test" {
+  name        = "%s"
+  namespace   = cosmo_namespace.test.name
+  routing_url = "https://subgraph-composition-preview-example.com"
+  schema      = "type Query { hello: String }"
+  labels      = {
+    "team" = "backend"
+  }
+}
+
+data "cosmo_composition_preview" "test" {
+  federated_graph = "%s"
+  namespace       = cosmo_subgraph.test.namespace
+  label_matchers  = ["team=backend"]
+
+  subgraphs = [
+    {
+      name   = cosmo_subgraph.test.name
+      schema = %q
+    }
+  ]
+}
+`, namespace, subgraphName, federatedGraphName, schema)
+}
@@ -0,0 +1,270 @@
+package composition
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/api"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/utils"
+)
+
+const (
+	defaultLimit     = 10
+	maxLimit         = 50
+	defaultDateRange = 7 * 24 * time.Hour
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CompositionsDataSource{}
+
+var compositionAttributeTypes = map[string]attr.Type{
+	"id":                         types.StringType,
+	"schema_version_id":          types.StringType,
+	"created_at":                 types.StringType,
+	"created_by":                 types.StringType,
+	"is_composable":              types.BoolType,
+	"is_latest_valid":            types.BoolType,
+	"composition_errors":         types.StringType,
+	"admission_error":            types.StringType,
+	"deployment_error":           types.StringType,
+	"triggered_by_subgraph_name": types.StringType,
+}
+
+func NewCompositionsDataSource() datasource.DataSource {
+	return &CompositionsDataSource{}
+}
+
+// CompositionsDataSource defines the data source implementation.
+type CompositionsDataSource struct {
+	client *api.PlatformClient
+}
+
+// CompositionsDataSourceModel describes the data source data model.
+type CompositionsDataSourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	FederatedGraph types.String `tfsdk:"federated_graph"`
+	Namespace      types.String `tfsdk:"namespace"`
+	StartDate      types.String `tfsdk:"start_date"`
+	EndDate        types.String `tfsdk:"end_date"`
+	Limit          types.Int64  `tfsdk:"limit"`
+	Offset         types.Int64  `tfsdk:"offset"`
+	Compositions   types.List   `tfsdk:"compositions"`
+}
+
+// Metadata returns the data source type name.
+func (d *CompositionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_compositions"
+}
+
+// Schema defines the schema for the data source.
+func (d *CompositionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Cosmo Compositions Data Source. Lists the recent compositions of a federated graph, most recent first.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the data source, in the format `<namespace>/<federated_graph>`.",
+			},
+			"federated_graph": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the federated graph to list the compositions of.",
+			},
+			"namespace": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The namespace in which the federated graph is located. Defaults to 'default'.",
+			},
+			"start_date": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list compositions created after this RFC 3339 timestamp. Defaults to 7 days before `end_date`.",
+			},
+			"end_date": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list compositions created before this RFC 3339 timestamp. Defaults to now.",
+			},
+			"limit": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf("The maximum number of compositions to list, between 1 and %d. Defaults to %d.", maxLimit, defaultLimit),
+				Validators: []validator.Int64{
+					int64validator.Between(1, maxLimit),
+				},
+			},
+			"offset": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "The number of compositions to skip. Defaults to 0.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"compositions": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The compositions of the federated graph.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The identifier of the composition.",
+						},
+						"schema_version_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The identifier of the composed schema version.",
+						},
+						"created_at": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The timestamp at which the composition was created.",
+						},
+						"created_by": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The user or api key that triggered the composition.",
+						},
+						"is_composable": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the subgraphs could be composed.",
+						},
+						"is_latest_valid": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether this is the latest valid composition, the one served to the routers.",
+						},
+						"composition_errors": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The errors reported by the composition, if any.",
+						},
+						"admission_error": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The error returned by the admission webhook, if any.",
+						},
+						"deployment_error": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The error raised while deploying the router config, if any.",
+						},
+						"triggered_by_subgraph_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the subgraph whose publish triggered the composition.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure prepares the data source for reading.
+func (d *CompositionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.PlatformClient)
+	if !ok {
+		utils.AddDiagnosticError(resp,
+			ErrUnexpectedDataSourceType,
+			fmt.Sprintf("Expected *api.PlatformClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the data source data.
+func (d *CompositionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CompositionsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.FederatedGraph.IsNull() || data.FederatedGraph.ValueString() == "" {
+		utils.AddDiagnosticError(resp,
+			ErrInvalidGraphName,
+			"The 'federated_graph' attribute is required.",
+		)
+		return
+	}
+
+	namespace := data.Namespace.ValueString()
+	if namespace == "" {
+		namespace = "default"
+	}
+
+	startDate, endDate, err := resolveDateRange(data.StartDate, data.EndDate)
+	if err != nil {
+		utils.AddDiagnosticError(resp,
+			ErrInvalidDateRange,
+			err.Error(),
+		)
+		return
+	}
+
+	limit := int64(defaultLimit)
+	if !data.Limit.IsNull() {
+		limit = data.Limit.ValueInt64()
+	}
+
+	compositions, apiError := d.client.GetCompositions(ctx, data.FederatedGraph.ValueString(), namespace, startDate.Format(time.RFC3339), endDate.Format(time.RFC3339), int32(limit), int32(data.Offset.ValueInt64()))
+	if apiError != nil {
+		utils.AddDiagnosticError(resp,
+			ErrReadingCompositions,
+			fmt.Sprintf("Could not read compositions of federated graph '%s' in namespace '%s': %s", data.FederatedGraph.ValueString(), namespace, apiError.Error()),
+		)
+		return
+	}
+
+	var values []attr.Value
+	for _, composition := range compositions {
+		values = append(values, types.ObjectValueMust(compositionAttributeTypes, map[string]attr.Value{
+			"id":                         types.StringValue(composition.GetId()),
+			"schema_version_id":          types.StringValue(composition.GetSchemaVersionId()),
+			"created_at":                 types.StringValue(composition.GetCreatedAt()),
+			"created_by":                 types.StringPointerValue(composition.CreatedBy),
+			"is_composable":              types.BoolValue(composition.GetIsComposable()),
+			"is_latest_valid":            types.BoolValue(composition.GetIsLatestValid()),
+			"composition_errors":         types.StringPointerValue(composition.CompositionErrors),
+			"admission_error":            types.StringPointerValue(composition.AdmissionError),
+			"deployment_error":           types.StringPointerValue(composition.DeploymentError),
+			"triggered_by_subgraph_name": types.StringPointerValue(composition.TriggeredBySubgraphName),
+		}))
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%s/%s", namespace, data.FederatedGraph.ValueString()))
+	data.Namespace = types.StringValue(namespace)
+	data.Compositions = types.ListValueMust(types.ObjectType{AttrTypes: compositionAttributeTypes}, values)
+
+	utils.LogAction(ctx, "read", data.Id.ValueString(), data.FederatedGraph.ValueString(), namespace)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// resolveDateRange parses the configured date range, defaulting to the last 7 days.
+func resolveDateRange(start, end types.String) (time.Time, time.Time, error) {
+	endDate := time.Now().UTC()
+	if !end.IsNull() && end.ValueString() != "" {
+		parsed, err := time.Parse(time.RFC3339, end.ValueString())
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("'end_date' must be an RFC 3339 timestamp: %w", err)
+		}
+		endDate = parsed
+	}
+
+	startDate := endDate.Add(-defaultDateRange)
+	if !start.IsNull() && start.ValueString() != "" {
+		parsed, err := time.Parse(time.RFC3339, start.ValueString())
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("'start_date' must be an RFC 3339 timestamp: %w", err)
+		}
+		startDate = parsed
+	}
+
+	if startDate.After(endDate) {
+		return time.Time{}, time.Time{}, fmt.Errorf("'start_date' must be before 'end_date'")
+	}
+
+	return startDate, endDate, nil
+}
@@ -0,0 +1,84 @@
+package composition_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/acceptance"
+)
+
+func TestAccCompositionsDataSource(t *testing.T) {
+	namespace := acctest.RandomWithPrefix("test-namespace")
+	federatedGraphName := acctest.RandomWithPrefix("test-federated-graph")
+	subgraphName := acctest.RandomWithPrefix("test-subgraph")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCompositionsDataSourceConfig(namespace, federatedGraphName, subgraphName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.cosmo_compositions.test", "federated_graph", federatedGraphName),
+					resource.TestCheckResourceAttr("data.cosmo_compositions.test", "namespace", namespace),
+					resource.TestCheckResourceAttrSet("data.cosmo_compositions.test", "compositions.0.id"),
+					resource.TestCheckResourceAttr("data.cosmo_compositions.test", "compositions.0.is_composable", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCompositionsDataSourceInvalidDateRange(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "cosmo_compositions" "test" {
+  federated_graph = "test"
+  start_date      = "2024-02-01T00:00:00Z"
+  end_date        = "2024-01-01T00:00:00Z"
+}
+`,
+				ExpectError: regexp.MustCompile(`'start_date' must be before 'end_date'`),
+			},
+		},
+	})
+}
+
+func testAccCompositionsDataSourceConfig(namespace, federatedGraphName, subgraphName string) string {
+	return fmt.Sprintf(`
+resource "cosmo_namespace" "test" {
+  name = "%s"
+}
+
+resource "cosmo_federated_graph" "test" {
+  name           = "%s"
+  namespace      = cosmo_namespace.test.name
+  routing_url    = "https://example.com"
+  label_matchers = ["team=backend"]
+
+  depends_on = [cosmo_subgraph.test]
+}
+
+resource "cosmo_subgraph" "test" {
+  name        = "%s"
+  namespace   = cosmo_namespace.test.name
+  routing_url = "https://subgraph-compositions-example.com"
+  schema      = "type Query { hello: String }"
+  labels      = {
+    "team" = "backend"
+  }
+}
+
+data "cosmo_compositions" "test" {
+  federated_graph = cosmo_federated_graph.test.name
+  namespace       = cosmo_federated_graph.test.namespace
+}
+`, namespace, federatedGraphName, subgraphName)
+}
@@ -0,0 +1,9 @@
+package composition
+
+const (
+	ErrInvalidGraphName         = "Invalid Federated Graph Name"
+	ErrInvalidDateRange         = "Invalid Date Range"
+	ErrReadingCompositions      = "Error Reading Compositions"
+	ErrUnexpectedDataSourceType = "Unexpected Data Source Configure Type"
+	ErrPreviewingComposition    = "Error Previewing Composition"
+)
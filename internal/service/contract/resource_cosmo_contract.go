@@ -4,12 +4,14 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	common "github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/common"
 	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/api"
 	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/utils"
 )
@@ -63,6 +65,9 @@ For more information, refer to the Cosmo Documentation at https://cosmo-docs.wun
 			},
 			"source": schema.StringAttribute{
 				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"exclude_tags": schema.ListAttribute{
 				Optional:    true,
@@ -170,6 +175,27 @@ func (r *contractResource) Read(ctx context.Context, req resource.ReadRequest, r
 	data.Namespace = types.StringValue(graph.GetNamespace())
 	data.RoutingURL = types.StringValue(graph.GetRoutingURL())
 
+	if contract := graph.GetContract(); contract != nil {
+		var excludeTags []attr.Value
+		for _, tag := range contract.GetExcludeTags() {
+			excludeTags = append(excludeTags, types.StringValue(tag))
+		}
+		// keep an unset list unset as long as the contract excludes no tags
+		if len(excludeTags) > 0 || !data.ExcludeTags.IsNull() {
+			data.ExcludeTags = types.ListValueMust(types.StringType, excludeTags)
+		}
+
+		sourceGraphName, apiError := r.getSourceGraphName(ctx, contract.GetSourceFederatedGraphId(), graph.GetNamespace())
+		if apiError != nil {
+			utils.AddDiagnosticError(resp,
+				ErrReadingContract,
+				apiError.Error(),
+			)
+			return
+		}
+		data.SourceGraphName = types.StringValue(sourceGraphName)
+	}
+
 	utils.LogAction(ctx, "read", data.Id.ValueString(), data.Name.ValueString(), data.Namespace.ValueString())
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -192,7 +218,12 @@ func (r *contractResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
-	_, apiError := r.client.UpdateContract(ctx, data.Name.ValueString(), data.Namespace.ValueString(), excludeTags)
+	var admissionWebhookSecret *string
+	if !data.AdmissionWebhookSecret.IsNull() {
+		admissionWebhookSecret = data.AdmissionWebhookSecret.ValueStringPointer()
+	}
+
+	_, apiError := r.client.UpdateContract(ctx, data.Name.ValueString(), data.Namespace.ValueString(), excludeTags, data.RoutingURL.ValueString(), data.AdmissionWebhookUrl.ValueStringPointer(), admissionWebhookSecret, data.Readme.ValueStringPointer())
 	if apiError != nil {
 		if api.IsContractCompositionFailedError(apiError) || api.IsSubgraphCompositionFailedError(apiError) {
 			utils.AddDiagnosticWarning(resp,
@@ -242,6 +273,22 @@ func (r *contractResource) Delete(ctx context.Context, req resource.DeleteReques
 	}
 }
 
+// getSourceGraphName resolves the name of the source graph of a contract, which always lives in the namespace of the contract.
+func (r *contractResource) getSourceGraphName(ctx context.Context, sourceGraphId, namespace string) (string, *api.ApiError) {
+	graphs, apiError := r.client.GetFederatedGraphs(ctx, namespace)
+	if apiError != nil {
+		return "", apiError
+	}
+
+	for _, graph := range graphs {
+		if graph.GetId() == sourceGraphId {
+			return graph.GetName(), nil
+		}
+	}
+
+	return "", api.NewApiErrorWithErr(common.EnumStatusCode_ERR_NOT_FOUND, fmt.Sprintf("source graph '%s' of the contract not found in namespace '%s'", sourceGraphId, namespace), api.ErrNotFound)
+}
+
 func (r *contractResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
@@ -14,6 +14,7 @@ func TestAccContractResource(t *testing.T) {
 	namespace := acctest.RandomWithPrefix("test-namespace")
 
 	readme := "Initial readme content"
+	updatedReadme := "Updated readme content"
 
 	federatedGraphName := acctest.RandomWithPrefix("test-federated-graph")
 	federatedGraphroutingURL := "https://example.com:3000"
@@ -32,6 +33,20 @@ func TestAccContractResource(t *testing.T) {
 					resource.TestCheckResourceAttr("cosmo_contract.test", "readme", readme),
 				),
 			},
+			{
+				Config: testAccContractResourceConfig(namespace, federatedGraphName, federatedGraphroutingURL, graphUrl, name, updatedReadme),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cosmo_contract.test", "readme", updatedReadme),
+				),
+			},
+			{
+				ResourceName: "cosmo_contract.test",
+				RefreshState: true,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cosmo_contract.test", "source", federatedGraphName),
+					resource.TestCheckNoResourceAttr("cosmo_contract.test", "exclude_tags"),
+				),
+			},
 		},
 	})
 }
@@ -58,3 +73,60 @@ resource "cosmo_contract" "test" {
 }
 `, namespace, federatedGraphName, federatedGraphroutingURL, graphUrl, contractName, contractReadme)
 }
+
+func TestAccContractResourceUpdateWhileCompositionFails(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-contract")
+	namespace := acctest.RandomWithPrefix("test-namespace")
+	monographName := acctest.RandomWithPrefix("test-monograph")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContractCompositionFailureConfig(namespace, monographName, name, "http://localhost:3003"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cosmo_contract.test", "routing_url", "http://localhost:3003"),
+				),
+			},
+			{
+				// excluding every field of the schema fails the composition of the contract, the routing URL is updated regardless
+				Config: testAccContractCompositionFailureConfig(namespace, monographName, name, "http://localhost:3004"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cosmo_contract.test", "routing_url", "http://localhost:3004"),
+				),
+			},
+			{
+				ResourceName: "cosmo_contract.test",
+				RefreshState: true,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cosmo_contract.test", "routing_url", "http://localhost:3004"),
+				),
+			},
+		},
+	})
+}
+
+func testAccContractCompositionFailureConfig(namespace, monographName, contractName, routingURL string) string {
+	return fmt.Sprintf(`
+resource "cosmo_namespace" "test" {
+  name = "%s"
+}
+
+resource "cosmo_monograph" "test" {
+  name        = "%s"
+  namespace   = cosmo_namespace.test.name
+  routing_url = "https://example.com:3000"
+  graph_url   = "http://example.com/graphql"
+  schema      = "type Query { hello: String @tag(name: \"internal\") }"
+}
+
+resource "cosmo_contract" "test" {
+  name         = "%s"
+  namespace    = cosmo_namespace.test.name
+  source       = cosmo_monograph.test.name
+  routing_url  = "%s"
+  exclude_tags = ["internal"]
+}
+`, namespace, monographName, contractName, routingURL)
+}
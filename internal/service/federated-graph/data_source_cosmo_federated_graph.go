@@ -34,6 +34,7 @@ type FederatedGraphDataSourceModel struct {
 	AdmissionWebhookUrl    types.String `tfsdk:"admission_webhook_url"`
 	AdmissionWebhookSecret types.String `tfsdk:"admission_webhook_secret"`
 	LabelMatchers          types.Map    `tfsdk:"label_matchers"`
+	Subgraphs              types.List   `tfsdk:"subgraphs"`
 }
 
 func (d *FederatedGraphDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -79,6 +80,31 @@ func (d *FederatedGraphDataSource) Schema(ctx context.Context, req datasource.Sc
 				MarkdownDescription: "The URL for the federated graph.",
 				Computed:            true,
 			},
+			"subgraphs": schema.ListNestedAttribute{
+				MarkdownDescription: "The subgraphs the federated graph is composed of.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the subgraph.",
+							Computed:            true,
+						},
+						"routing_url": schema.StringAttribute{
+							MarkdownDescription: "The routing URL of the subgraph.",
+							Computed:            true,
+						},
+						"labels": schema.MapAttribute{
+							MarkdownDescription: "The labels of the subgraph.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"is_feature_subgraph": schema.BoolAttribute{
+							MarkdownDescription: "Whether the subgraph is a feature subgraph.",
+							Computed:            true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -126,6 +152,7 @@ func (d *FederatedGraphDataSource) Read(ctx context.Context, req datasource.Read
 	data.Name = types.StringValue(graph.GetName())
 	data.Namespace = types.StringValue(graph.GetNamespace())
 	data.RoutingURL = types.StringValue(graph.GetRoutingURL())
+	data.Subgraphs = subgraphsValue(apiResponse.GetSubgraphs())
 
 	if graph.Readme != nil {
 		data.Readme = types.StringValue(*graph.Readme)
@@ -0,0 +1,132 @@
+package federated_graph
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/api"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &FederatedGraphSDLDataSource{}
+
+func NewFederatedGraphSDLDataSource() datasource.DataSource {
+	return &FederatedGraphSDLDataSource{}
+}
+
+// FederatedGraphSDLDataSource defines the data source implementation.
+type FederatedGraphSDLDataSource struct {
+	client *api.PlatformClient
+}
+
+// FederatedGraphSDLDataSourceModel describes the data source data model.
+type FederatedGraphSDLDataSourceModel struct {
+	Id           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Namespace    types.String `tfsdk:"namespace"`
+	Sdl          types.String `tfsdk:"sdl"`
+	ClientSchema types.String `tfsdk:"client_schema"`
+	VersionId    types.String `tfsdk:"version_id"`
+}
+
+// Metadata returns the data source type name.
+func (d *FederatedGraphSDLDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_federated_graph_sdl"
+}
+
+// Schema defines the schema for the data source.
+func (d *FederatedGraphSDLDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Cosmo Federated Graph SDL Data Source. Retrieves the composed schemas of a federated graph, monograph or contract.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the federated graph SDL, in the format `<namespace>/<name>`.",
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the federated graph, monograph or contract.",
+			},
+			"namespace": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The namespace in which the graph is located. Defaults to 'default'.",
+			},
+			"sdl": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The composed router schema, including the federation directives.",
+			},
+			"client_schema": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The client-facing schema, without the federation directives and inaccessible fields.",
+			},
+			"version_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The schema version of the latest valid composition.",
+			},
+		},
+	}
+}
+
+// Configure prepares the data source for reading.
+func (d *FederatedGraphSDLDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.PlatformClient)
+	if !ok {
+		utils.AddDiagnosticError(resp,
+			ErrUnexpectedDataSourceType,
+			fmt.Sprintf("Expected *api.PlatformClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the data source data.
+func (d *FederatedGraphSDLDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data FederatedGraphSDLDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Name.IsNull() || data.Name.ValueString() == "" {
+		utils.AddDiagnosticError(resp,
+			ErrInvalidGraphName,
+			"The 'name' attribute is required.",
+		)
+		return
+	}
+
+	namespace := data.Namespace.ValueString()
+	if namespace == "" {
+		namespace = "default"
+	}
+
+	sdl, apiError := d.client.GetFederatedGraphSDL(ctx, data.Name.ValueString(), namespace)
+	if apiError != nil {
+		utils.AddDiagnosticError(resp,
+			ErrReadingGraphSDL,
+			fmt.Sprintf("Could not read the SDL of graph '%s' in namespace '%s': %s", data.Name.ValueString(), namespace, apiError.Error()),
+		)
+		return
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%s/%s", namespace, data.Name.ValueString()))
+	data.Namespace = types.StringValue(namespace)
+	data.Sdl = types.StringValue(sdl.GetSdl())
+	data.ClientSchema = types.StringValue(sdl.GetClientSchema())
+	data.VersionId = types.StringValue(sdl.GetVersionId())
+
+	utils.LogAction(ctx, "read", data.Id.ValueString(), data.Name.ValueString(), namespace)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
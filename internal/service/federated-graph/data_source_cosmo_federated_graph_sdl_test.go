@@ -0,0 +1,66 @@
+package federated_graph_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/acceptance"
+)
+
+func TestAccFederatedGraphSDLDataSource(t *testing.T) {
+	namespace := acctest.RandomWithPrefix("test-namespace")
+	federatedGraphName := acctest.RandomWithPrefix("test-federated-graph")
+	subgraphName := acctest.RandomWithPrefix("test-subgraph")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFederatedGraphSDLDataSourceConfig(namespace, federatedGraphName, subgraphName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.cosmo_federated_graph_sdl.test", "name", federatedGraphName),
+					resource.TestCheckResourceAttr("data.cosmo_federated_graph_sdl.test", "namespace", namespace),
+					resource.TestMatchResourceAttr("data.cosmo_federated_graph_sdl.test", "sdl", regexp.MustCompile(`hello`)),
+					resource.TestMatchResourceAttr("data.cosmo_federated_graph_sdl.test", "client_schema", regexp.MustCompile(`hello`)),
+					resource.TestCheckResourceAttrSet("data.cosmo_federated_graph_sdl.test", "version_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFederatedGraphSDLDataSourceConfig(namespace, federatedGraphName, subgraphName string) string {
+	return fmt.Sprintf(`
+resource "cosmo_namespace" "test" {
+  name = "%s"
+}
+
+resource "cosmo_federated_graph" "test" {
+  name           = "%s"
+  namespace      = cosmo_namespace.test.name
+  routing_url    = "https://example.com"
+  label_matchers = ["team=backend"]
+
+  depends_on = [cosmo_subgraph.test]
+}
+
+resource "cosmo_subgraph" "test" {
+  name        = "%s"
+  namespace   = cosmo_namespace.test.name
+  routing_url = "https://subgraph-sdl-example.com"
+  schema      = "type Query { hello: String }"
+  labels      = {
+    "team" = "backend"
+  }
+}
+
+data "cosmo_federated_graph_sdl" "test" {
+  name      = cosmo_federated_graph.test.name
+  namespace = cosmo_federated_graph.test.namespace
+}
+`, namespace, federatedGraphName, subgraphName)
+}
@@ -12,16 +12,20 @@ import (
 func TestAccFederatedGraphDataSource(t *testing.T) {
 	name := acctest.RandomWithPrefix("test-federated-graph")
 	namespace := acctest.RandomWithPrefix("test-namespace")
+	subgraphName := acctest.RandomWithPrefix("test-subgraph")
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
 		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccFederatedGraphDataSourceConfig(namespace, name),
+				Config: testAccFederatedGraphDataSourceConfig(namespace, name, subgraphName),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("data.cosmo_federated_graph.test", "name", name),
 					resource.TestCheckResourceAttr("data.cosmo_federated_graph.test", "namespace", namespace),
+					resource.TestCheckResourceAttr("data.cosmo_federated_graph.test", "subgraphs.#", "1"),
+					resource.TestCheckResourceAttr("data.cosmo_federated_graph.test", "subgraphs.0.name", subgraphName),
+					resource.TestCheckResourceAttr("data.cosmo_federated_graph.test", "subgraphs.0.labels.team", "backend"),
 				),
 			},
 			{
@@ -32,7 +36,7 @@ func TestAccFederatedGraphDataSource(t *testing.T) {
 	})
 }
 
-func testAccFederatedGraphDataSourceConfig(namespace, name string) string {
+func testAccFederatedGraphDataSourceConfig(namespace, name, subgraphName string) string {
 	return fmt.Sprintf(`
 resource "cosmo_namespace" "test" {
   name = "%s"
@@ -42,11 +46,24 @@ resource "cosmo_federated_graph" "test" {
   name      = "%s"
   namespace = cosmo_namespace.test.name
   routing_url = "https://example.com"
+  label_matchers = ["team=backend"]
+
+  depends_on = [cosmo_subgraph.test]
+}
+
+resource "cosmo_subgraph" "test" {
+  name        = "%s"
+  namespace   = cosmo_namespace.test.name
+  routing_url = "https://subgraph-data-source-example.com"
+  schema      = "type Query { hello: String }"
+  labels      = {
+    "team" = "backend"
+  }
 }
 
 data "cosmo_federated_graph" "test" {
   name      = cosmo_federated_graph.test.name
   namespace = cosmo_federated_graph.test.namespace
 }
-`, namespace, name)
+`, namespace, name, subgraphName)
 }
@@ -12,6 +12,8 @@ const (
 	ErrUnexpectedDataSourceType = "Unexpected Data Source Configure Type"
 	ErrUnexpectedResourceType   = "Unexpected Resource Configure Type"
 	ErrGraphNotFound            = "Graph Not Found"
+	ErrReadingGraphSDL          = "Error Reading Federated Graph SDL"
+	ErrWaitingForDeployment     = "Error Waiting For Deployment"
 )
 
 const (
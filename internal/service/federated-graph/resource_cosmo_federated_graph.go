@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -13,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	common "github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/common"
@@ -44,6 +46,10 @@ type FederatedGraphResourceModel struct {
 	AdmissionWebhookUrl    types.String `tfsdk:"admission_webhook_url"`
 	AdmissionWebhookSecret types.String `tfsdk:"admission_webhook_secret"`
 	LabelMatchers          types.List   `tfsdk:"label_matchers"`
+	MatchedSubgraphs       types.List   `tfsdk:"matched_subgraphs"`
+	Subgraphs              types.List   `tfsdk:"subgraphs"`
+
+	WaitForDeployment *utils.WaitForDeploymentModel `tfsdk:"wait_for_deployment"`
 }
 
 func (r *FederatedGraphResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -100,7 +106,7 @@ For more information on federated graphs, please refer to the [Cosmo Documentati
 				Required:            true,
 			},
 			"label_matchers": schema.ListAttribute{
-				MarkdownDescription: "A list of label matchers used to select the services that will form the federated graph.",
+				MarkdownDescription: "A list of label matchers used to select the services that will form the federated graph. Each matcher is a comma separated list of `key=value` labels, a subgraph is selected when it has at least one label of every matcher.",
 				Optional:            true,
 				ElementType:         types.StringType,
 				Computed:            true,
@@ -108,8 +114,50 @@ For more information on federated graphs, please refer to the [Cosmo Documentati
 				PlanModifiers: []planmodifier.List{
 					listplanmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.List{
+					utils.LabelMatchersValidator(),
+				},
+			},
+			"matched_subgraphs": schema.ListAttribute{
+				MarkdownDescription: "The names of the subgraphs selected by the label matchers, the same as the names of `subgraphs`.",
+				Computed:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.List{
+					subgraphsPlanModifier{},
+				},
+			},
+			"subgraphs": schema.ListNestedAttribute{
+				MarkdownDescription: "The subgraphs selected by the label matchers, which the federated graph is composed of.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.List{
+					subgraphsPlanModifier{},
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the subgraph.",
+							Computed:            true,
+						},
+						"routing_url": schema.StringAttribute{
+							MarkdownDescription: "The routing URL of the subgraph.",
+							Computed:            true,
+						},
+						"labels": schema.MapAttribute{
+							MarkdownDescription: "The labels of the subgraph.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"is_feature_subgraph": schema.BoolAttribute{
+							MarkdownDescription: "Whether the subgraph is a feature subgraph.",
+							Computed:            true,
+						},
+					},
+				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"wait_for_deployment": utils.WaitForDeploymentBlock(),
+		},
 	}
 }
 
@@ -138,10 +186,7 @@ func (r *FederatedGraphResource) Create(ctx context.Context, req resource.Create
 	response, apiError := r.createFederatedGraph(ctx, data, resp)
 	if apiError != nil {
 		if !api.IsSubgraphCompositionFailedError(apiError) {
-			utils.AddDiagnosticError(resp,
-				ErrCreatingGraph,
-				apiError.Error(),
-			)
+			utils.AddCompositionDiagnostics(resp, diag.SeverityError, ErrCreatingGraph, apiError.Error(), apiError.CompositionIssues())
 			return
 		}
 	}
@@ -151,6 +196,10 @@ func (r *FederatedGraphResource) Create(ctx context.Context, req resource.Create
 	data.Name = types.StringValue(graph.GetName())
 	data.Namespace = types.StringValue(graph.GetNamespace())
 	data.RoutingURL = types.StringValue(graph.GetRoutingURL())
+	data.MatchedSubgraphs = matchedSubgraphsValue(response.GetSubgraphs())
+	data.Subgraphs = subgraphsValue(response.GetSubgraphs())
+
+	r.waitForDeployment(ctx, resp, data)
 
 	utils.LogAction(ctx, DebugCreate, data.Id.ValueString(), data.Name.ValueString(), data.Namespace.ValueString())
 
@@ -195,6 +244,8 @@ func (r *FederatedGraphResource) Read(ctx context.Context, req resource.ReadRequ
 		labelMatchers = append(labelMatchers, types.StringValue(matcher))
 	}
 	data.LabelMatchers = types.ListValueMust(types.StringType, labelMatchers)
+	data.MatchedSubgraphs = matchedSubgraphsValue(apiResponse.GetSubgraphs())
+	data.Subgraphs = subgraphsValue(apiResponse.GetSubgraphs())
 
 	utils.LogAction(ctx, "read", data.Id.ValueString(), data.Name.ValueString(), data.Namespace.ValueString())
 
@@ -236,19 +287,31 @@ func (r *FederatedGraphResource) Update(ctx context.Context, req resource.Update
 	_, apiError := r.client.UpdateFederatedGraph(ctx, admissionWebhookSecret, &graph)
 	if apiError != nil {
 		if api.IsSubgraphCompositionFailedError(apiError) {
-			utils.AddDiagnosticWarning(resp,
-				ErrCompositionError,
-				apiError.Error(),
-			)
+			utils.AddCompositionDiagnostics(resp, diag.SeverityWarning, ErrCompositionError, apiError.Error(), apiError.CompositionIssues())
 		} else {
-			utils.AddDiagnosticError(resp,
-				ErrUpdatingGraph,
-				apiError.Error(),
-			)
+			utils.AddCompositionDiagnostics(resp, diag.SeverityError, ErrUpdatingGraph, apiError.Error(), apiError.CompositionIssues())
+			return
+		}
+	}
+
+	// subgraphs planned from the state are kept, subgraphs created or relabelled in the same apply are picked up
+	// by the next read, as they would not match the plan
+	if data.MatchedSubgraphs.IsUnknown() || data.Subgraphs.IsUnknown() {
+		apiResponse, apiError := r.client.GetFederatedGraph(ctx, data.Name.ValueString(), data.Namespace.ValueString())
+		if apiError != nil {
+			utils.AddDiagnosticError(resp, ErrReadingGraph, apiError.Error())
 			return
 		}
+		if data.MatchedSubgraphs.IsUnknown() {
+			data.MatchedSubgraphs = matchedSubgraphsValue(apiResponse.GetSubgraphs())
+		}
+		if data.Subgraphs.IsUnknown() {
+			data.Subgraphs = subgraphsValue(apiResponse.GetSubgraphs())
+		}
 	}
 
+	r.waitForDeployment(ctx, resp, data)
+
 	utils.LogAction(ctx, "updated", data.Id.ValueString(), data.Name.ValueString(), data.Namespace.ValueString())
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -313,7 +376,7 @@ func (r *FederatedGraphResource) createFederatedGraph(ctx context.Context, data
 	_, apiError := r.client.CreateFederatedGraph(ctx, admissionWebhookSecret, &apiGraph)
 	if apiError != nil {
 		if api.IsSubgraphCompositionFailedError(apiError) {
-			utils.AddDiagnosticWarning(resp, ErrCompositionError, apiError.Error())
+			utils.AddCompositionDiagnostics(resp, diag.SeverityWarning, ErrCompositionError, apiError.Error(), apiError.CompositionIssues())
 		} else {
 			return nil, apiError
 		}
@@ -331,3 +394,23 @@ func (r *FederatedGraphResource) createFederatedGraph(ctx context.Context, data
 
 	return response, nil
 }
+
+func (r *FederatedGraphResource) waitForDeployment(ctx context.Context, resp interface{}, data FederatedGraphResourceModel) {
+	if data.WaitForDeployment == nil {
+		return
+	}
+
+	timeout, pollInterval := data.WaitForDeployment.Durations()
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	apiError := r.client.WaitForDeployment(ctx, data.Name.ValueString(), data.Namespace.ValueString(), pollInterval)
+	if apiError != nil {
+		// a failed composition is reported by the apply itself, there is just nothing to wait for
+		if api.IsSubgraphCompositionFailedError(apiError) {
+			utils.AddDiagnosticWarning(resp, ErrWaitingForDeployment, apiError.Error())
+			return
+		}
+		utils.AddDiagnosticError(resp, ErrWaitingForDeployment, apiError.Error())
+	}
+}
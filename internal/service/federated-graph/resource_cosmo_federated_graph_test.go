@@ -69,6 +69,164 @@ func TestAccFederatedGraphResourceInvalidConfig(t *testing.T) {
 	})
 }
 
+func TestAccFederatedGraphResourceSubgraphs(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-federated-graph")
+	namespace := acctest.RandomWithPrefix("test-namespace")
+	subgraphName := acctest.RandomWithPrefix("test-subgraph")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFederatedGraphResourceSubgraphsConfig(namespace, name, subgraphName, "team=backend"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cosmo_federated_graph.test", "matched_subgraphs.#", "1"),
+					resource.TestCheckResourceAttr("cosmo_federated_graph.test", "matched_subgraphs.0", subgraphName),
+					resource.TestCheckResourceAttr("cosmo_federated_graph.test", "subgraphs.#", "1"),
+					resource.TestCheckResourceAttr("cosmo_federated_graph.test", "subgraphs.0.name", subgraphName),
+					resource.TestCheckResourceAttr("cosmo_federated_graph.test", "subgraphs.0.routing_url", "https://subgraph-subgraphs-example.com"),
+					resource.TestCheckResourceAttr("cosmo_federated_graph.test", "subgraphs.0.labels.team", "backend"),
+					resource.TestCheckResourceAttr("cosmo_federated_graph.test", "subgraphs.0.is_feature_subgraph", "false"),
+				),
+			},
+			{
+				Config: testAccFederatedGraphResourceSubgraphsConfig(namespace, name, subgraphName, "team=frontend"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cosmo_federated_graph.test", "matched_subgraphs.#", "0"),
+					resource.TestCheckResourceAttr("cosmo_federated_graph.test", "subgraphs.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFederatedGraphResourceSubgraphsConfig(namespace, name, subgraphName, labelMatcher string) string {
+	return fmt.Sprintf(`
+resource "cosmo_namespace" "test" {
+  name = "%s"
+}
+
+resource "cosmo_federated_graph" "test" {
+  name           = "%s"
+  namespace      = cosmo_namespace.test.name
+  routing_url    = "https://example.com"
+  label_matchers = ["%s"]
+
+  depends_on = [cosmo_subgraph.test]
+}
+
+resource "cosmo_subgraph" "test" {
+  name        = "%s"
+  namespace   = cosmo_namespace.test.name
+  routing_url = "https://subgraph-subgraphs-example.com"
+  schema      = "type Query { hello: String }"
+  labels      = {
+    "team" = "backend"
+  }
+}
+`, namespace, name, labelMatcher, subgraphName)
+}
+
+func TestAccFederatedGraphResourceSubgraphsChangedInSameApply(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-federated-graph")
+	namespace := acctest.RandomWithPrefix("test-namespace")
+	subgraphName := acctest.RandomWithPrefix("test-subgraph")
+	otherSubgraphName := acctest.RandomWithPrefix("test-subgraph")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFederatedGraphResourceSubgraphsChangedInSameApplyConfig(namespace, name, "https://example.com", subgraphName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cosmo_federated_graph.test", "subgraphs.#", "1"),
+				),
+			},
+			{
+				// the new subgraph matches the unchanged label matchers, the graph is updated in the same apply
+				Config: testAccFederatedGraphResourceSubgraphsChangedInSameApplyConfig(namespace, name, "https://updated-example.com", subgraphName, otherSubgraphName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cosmo_federated_graph.test", "routing_url", "https://updated-example.com"),
+				),
+			},
+			{
+				ResourceName: "cosmo_federated_graph.test",
+				RefreshState: true,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cosmo_federated_graph.test", "matched_subgraphs.#", "2"),
+					resource.TestCheckResourceAttr("cosmo_federated_graph.test", "subgraphs.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFederatedGraphResourceSubgraphsChangedInSameApplyConfig(namespace, name, routingURL, subgraphName, otherSubgraphName string) string {
+	config := fmt.Sprintf(`
+resource "cosmo_namespace" "test" {
+  name = "%s"
+}
+
+resource "cosmo_federated_graph" "test" {
+  name           = "%s"
+  namespace      = cosmo_namespace.test.name
+  routing_url    = "%s"
+  label_matchers = ["team=backend"]
+
+  depends_on = [cosmo_subgraph.test]
+}
+
+resource "cosmo_subgraph" "test" {
+  name        = "%s"
+  namespace   = cosmo_namespace.test.name
+  routing_url = "https://subgraph-same-apply-example.com"
+  schema      = "type Query { hello: String }"
+  labels      = {
+    "team" = "backend"
+  }
+}
+`, namespace, name, routingURL, subgraphName)
+
+	if otherSubgraphName != "" {
+		config += fmt.Sprintf(`
+resource "cosmo_subgraph" "other" {
+  name        = "%s"
+  namespace   = cosmo_namespace.test.name
+  routing_url = "https://other-subgraph-same-apply-example.com"
+  schema      = "type Query { world: String }"
+  labels      = {
+    "team" = "backend"
+  }
+}
+`, otherSubgraphName)
+	}
+
+	return config
+}
+
+func TestAccFederatedGraphResourceInvalidLabelMatchers(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-federated-graph")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "cosmo_federated_graph" "test" {
+  name           = "%s"
+  routing_url    = "https://example.com"
+  label_matchers = ["team=a,,env=b"]
+}
+`, name),
+				ExpectError: regexp.MustCompile(`label 2 is empty`),
+			},
+		},
+	})
+}
+
 func testAccFederatedGraphResourceConfig(namespace, name, routingURL, readme string) string {
 	return fmt.Sprintf(`
 resource "cosmo_namespace" "test" {
@@ -83,3 +241,62 @@ resource "cosmo_federated_graph" "test" {
 }
 `, namespace, name, routingURL, readme)
 }
+
+func TestAccFederatedGraphResourceWaitForDeployment(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-federated-graph")
+	namespace := acctest.RandomWithPrefix("test-namespace")
+	subgraphName := acctest.RandomWithPrefix("test-subgraph")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFederatedGraphResourceWaitForDeploymentConfig(namespace, name, subgraphName, "1s"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cosmo_federated_graph.test", "wait_for_deployment.timeout", "2m"),
+					resource.TestCheckResourceAttr("cosmo_subgraph.test", "wait_for_deployment.poll_interval", "1s"),
+				),
+			},
+			{
+				Config:      testAccFederatedGraphResourceWaitForDeploymentConfig(namespace, name, subgraphName, "soon"),
+				ExpectError: regexp.MustCompile(`must be a duration`),
+			},
+		},
+	})
+}
+
+func testAccFederatedGraphResourceWaitForDeploymentConfig(namespace, name, subgraphName, pollInterval string) string {
+	return fmt.Sprintf(`
+resource "cosmo_namespace" "test" {
+  name = "%s"
+}
+
+resource "cosmo_federated_graph" "test" {
+  name           = "%s"
+  namespace      = cosmo_namespace.test.name
+  routing_url    = "https://example.com"
+  label_matchers = ["team=backend"]
+
+  wait_for_deployment {
+    timeout = "2m"
+  }
+}
+
+resource "cosmo_subgraph" "test" {
+  name        = "%s"
+  namespace   = cosmo_namespace.test.name
+  routing_url = "https://subgraph-wait-for-deployment-example.com"
+  schema      = "type Query { hello: String }"
+  labels      = {
+    "team" = "backend"
+  }
+
+  wait_for_deployment {
+    poll_interval = "%s"
+  }
+
+  depends_on = [cosmo_federated_graph.test]
+}
+`, namespace, name, subgraphName, pollInterval)
+}
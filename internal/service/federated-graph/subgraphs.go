@@ -0,0 +1,74 @@
+package federated_graph
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	platformv1 "github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/platform/v1"
+)
+
+var subgraphAttributeTypes = map[string]attr.Type{
+	"name":                types.StringType,
+	"routing_url":         types.StringType,
+	"labels":              types.MapType{ElemType: types.StringType},
+	"is_feature_subgraph": types.BoolType,
+}
+
+// matchedSubgraphsValue lists the names of the subgraphs the federated graph is composed of.
+func matchedSubgraphsValue(subgraphs []*platformv1.Subgraph) types.List {
+	names := make([]attr.Value, 0, len(subgraphs))
+	for _, subgraph := range subgraphs {
+		names = append(names, types.StringValue(subgraph.GetName()))
+	}
+	return types.ListValueMust(types.StringType, names)
+}
+
+// subgraphsValue describes the subgraphs the federated graph is composed of.
+func subgraphsValue(subgraphs []*platformv1.Subgraph) types.List {
+	values := make([]attr.Value, 0, len(subgraphs))
+	for _, subgraph := range subgraphs {
+		labels := make(map[string]attr.Value, len(subgraph.GetLabels()))
+		for _, label := range subgraph.GetLabels() {
+			labels[label.GetKey()] = types.StringValue(label.GetValue())
+		}
+
+		values = append(values, types.ObjectValueMust(subgraphAttributeTypes, map[string]attr.Value{
+			"name":                types.StringValue(subgraph.GetName()),
+			"routing_url":         types.StringValue(subgraph.GetRoutingURL()),
+			"labels":              types.MapValueMust(types.StringType, labels),
+			"is_feature_subgraph": types.BoolValue(subgraph.GetIsFeatureSubgraph()),
+		}))
+	}
+	return types.ListValueMust(types.ObjectType{AttrTypes: subgraphAttributeTypes}, values)
+}
+
+// subgraphsPlanModifier keeps the subgraphs of the state in the plan as long as the label matchers selecting
+// them don't change, instead of showing them as known after apply on every update.
+type subgraphsPlanModifier struct{}
+
+func (m subgraphsPlanModifier) Description(ctx context.Context) string {
+	return "The subgraphs only change when the label matchers change."
+}
+
+func (m subgraphsPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return "The subgraphs only change when the `label_matchers` change."
+}
+
+func (m subgraphsPlanModifier) PlanModifyList(ctx context.Context, req planmodifier.ListRequest, resp *planmodifier.ListResponse) {
+	if req.StateValue.IsNull() || !req.PlanValue.IsUnknown() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var planLabelMatchers, stateLabelMatchers types.List
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("label_matchers"), &planLabelMatchers)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("label_matchers"), &stateLabelMatchers)...)
+
+	if resp.Diagnostics.HasError() || !planLabelMatchers.Equal(stateLabelMatchers) {
+		return
+	}
+
+	resp.PlanValue = req.StateValue
+}
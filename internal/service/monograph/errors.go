@@ -9,7 +9,10 @@ const (
 	ErrReadingMonograph         = "Error Reading Monograph"
 	ErrUpdatingMonograph        = "Error Updating Monograph"
 	ErrDeletingMonograph        = "Error Deleting Monograph"
+	ErrPublishingMonograph      = "Error Publishing Monograph"
+	ErrReadingSchemaFile        = "Error Reading Schema File"
 	ErrUnexpectedDataSourceType = "Unexpected Data Source Configure Type"
 	ErrUnexpectedResourceType   = "Unexpected Resource Configure Type"
 	ErrMonographNotFound        = "Monograph Not Found"
+	ErrClearingMonographFields  = "Monograph Fields Will Be Cleared"
 )
@@ -3,8 +3,10 @@ package monograph
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -17,6 +19,8 @@ import (
 	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/utils"
 )
 
+var _ resource.ResourceWithModifyPlan = &MonographResource{}
+
 type MonographResource struct {
 	client *api.PlatformClient
 }
@@ -33,6 +37,9 @@ type MonographResourceModel struct {
 	Readme                 types.String `tfsdk:"readme"`
 	AdmissionWebhookURL    types.String `tfsdk:"admission_webhook_url"`
 	AdmissionWebhookSecret types.String `tfsdk:"admission_webhook_secret"`
+	Schema                 types.String `tfsdk:"schema"`
+	SchemaFile             types.String `tfsdk:"schema_file"`
+	SchemaSha256           types.String `tfsdk:"schema_sha256"`
 }
 
 func NewMonographResource() resource.Resource {
@@ -48,6 +55,8 @@ func (r *MonographResource) Schema(ctx context.Context, req resource.SchemaReque
 		MarkdownDescription: `
 A monograph is a resource that represents a single subgraph with GraphQL Federation disabled.
 
+Optional fields that are not configured are left untouched on the platform. Removing a previously configured field clears it, the plan warns about the fields that will be cleared.
+
 For more information on monographs, please refer to the [Cosmo Documentation](https://cosmo-docs.wundergraph.com/cli/monograph).
 		`,
 		Attributes: map[string]schema.Attribute{
@@ -109,6 +118,21 @@ For more information on monographs, please refer to the [Cosmo Documentation](ht
 					stringvalidator.OneOf(api.GraphQLSubscriptionProtocolWS, api.GraphQLSubscriptionProtocolSSE, api.GraphQLSubscriptionProtocolSSEPost),
 				},
 			},
+			"schema": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf("The schema of the monograph. A failed composition is reported according to the `composition_failure_mode` of the provider, `%s` is reported as `%s` as monographs are not rolled back. Outside of `warn` mode the failed schema is not stored in the state, so it is published again on the next apply. Changes to the published schema made outside of Terraform are detected on refresh.", api.CompositionFailureModeRollback, api.CompositionFailureModeError),
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("schema_file")),
+				},
+			},
+			"schema_file": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The path of a file containing the schema of the monograph. The provider reads the file itself and only stores its hash in the state, the schema is republished when the hash changes.",
+			},
+			"schema_sha256": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The sha256 of the published schema, without surrounding whitespace.",
+			},
 		},
 	}
 }
@@ -130,6 +154,80 @@ func (r *MonographResource) Configure(ctx context.Context, req resource.Configur
 	r.client = client
 }
 
+// ModifyPlan computes the hash of the schema so that changes to the schema file show up in the plan, and
+// warns about optional fields that were removed from the configuration or set to an empty value, as the
+// update clears them on the platform instead of leaving them untouched.
+func (r *MonographResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan MonographResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Schema.IsUnknown() && !plan.SchemaFile.IsUnknown() {
+		schema, err := utils.LoadSchema(plan.Schema, plan.SchemaFile)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("schema_file"), ErrReadingSchemaFile, err.Error())
+			return
+		}
+
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("schema_sha256"), schemaSha256Value(schema))...)
+	}
+
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var state MonographResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cleared := clearedFields([]optionalField{
+		{"subscription_url", plan.SubscriptionUrl, state.SubscriptionUrl},
+		{"websocket_subprotocol", plan.WebsocketSubprotocol, state.WebsocketSubprotocol},
+		{"subscription_protocol", plan.SubscriptionProtocol, state.SubscriptionProtocol},
+		{"readme", plan.Readme, state.Readme},
+		{"admission_webhook_url", plan.AdmissionWebhookURL, state.AdmissionWebhookURL},
+		{"admission_webhook_secret", plan.AdmissionWebhookSecret, state.AdmissionWebhookSecret},
+	})
+	if len(cleared) == 0 {
+		return
+	}
+
+	resp.Diagnostics.AddWarning(
+		ErrClearingMonographFields,
+		fmt.Sprintf("The following fields of monograph '%s' in namespace '%s' will be cleared: %s.", plan.Name.ValueString(), plan.Namespace.ValueString(), strings.Join(cleared, ", ")),
+	)
+}
+
+type optionalField struct {
+	name  string
+	plan  types.String
+	state types.String
+}
+
+// clearedFields returns the names of the fields that are set on the platform and will be emptied by the plan.
+func clearedFields(fields []optionalField) []string {
+	var cleared []string
+	for _, field := range fields {
+		if field.plan.IsUnknown() || field.state.IsNull() || field.state.ValueString() == "" {
+			continue
+		}
+		if field.plan.IsNull() || field.plan.ValueString() == "" {
+			cleared = append(cleared, field.name)
+		}
+	}
+	return cleared
+}
+
 func (r *MonographResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data MonographResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -146,6 +244,16 @@ func (r *MonographResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
+	schema, err := utils.LoadSchema(data.Schema, data.SchemaFile)
+	if err != nil {
+		utils.AddDiagnosticError(resp, ErrReadingSchemaFile, err.Error())
+		return
+	}
+
+	if data.SchemaSha256.IsUnknown() {
+		data.SchemaSha256 = schemaSha256Value(schema)
+	}
+
 	_, apiError := r.client.CreateMonograph(
 		ctx,
 		data.Name.ValueString(),
@@ -154,10 +262,10 @@ func (r *MonographResource) Create(ctx context.Context, req resource.CreateReque
 		data.GraphUrl.ValueString(),
 		utils.StringValueOrNil(data.SubscriptionUrl),
 		utils.StringValueOrNil(data.Readme),
-		data.WebsocketSubprotocol.ValueString(),
-		data.SubscriptionProtocol.ValueString(),
-		data.AdmissionWebhookURL.ValueString(),
-		data.AdmissionWebhookSecret.ValueString(),
+		utils.StringValueOrNil(data.WebsocketSubprotocol),
+		utils.StringValueOrNil(data.SubscriptionProtocol),
+		utils.StringValueOrNil(data.AdmissionWebhookURL),
+		utils.StringValueOrNil(data.AdmissionWebhookSecret),
 	)
 	if apiError != nil {
 		utils.AddDiagnosticError(resp,
@@ -177,8 +285,14 @@ func (r *MonographResource) Create(ctx context.Context, req resource.CreateReque
 	}
 
 	data.Id = types.StringValue(monograph.GetId())
-	if monograph.Readme != nil {
-		data.Readme = types.StringValue(*monograph.Readme)
+	if monograph.Readme != nil && (!data.Readme.IsNull() || monograph.GetReadme() != "") {
+		data.Readme = types.StringValue(monograph.GetReadme())
+	}
+
+	if schema != "" && !r.publishMonographSchema(ctx, resp, data, schema) {
+		// the monograph exists, keep it in the state so the schema is published again on the next apply
+		data.Schema = types.StringNull()
+		data.SchemaSha256 = types.StringNull()
 	}
 
 	utils.LogAction(ctx, "created", data.Id.ValueString(), data.Name.ValueString(), data.Namespace.ValueString())
@@ -216,8 +330,31 @@ func (r *MonographResource) Read(ctx context.Context, req resource.ReadRequest,
 	data.Namespace = types.StringValue(monograph.GetNamespace())
 	data.RoutingURL = types.StringValue(monograph.GetRoutingURL())
 
-	if monograph.Readme != nil {
-		data.Readme = types.StringValue(*monograph.Readme)
+	// an empty readme is the platform's way of saying there is none, keep it null when it isn't configured
+	if monograph.Readme != nil && (!data.Readme.IsNull() || monograph.GetReadme() != "") {
+		data.Readme = types.StringValue(monograph.GetReadme())
+	}
+
+	if !data.Schema.IsNull() || !data.SchemaSha256.IsNull() {
+		sdl, apiError := r.client.GetLatestSubgraphSDL(ctx, data.Name.ValueString(), data.Namespace.ValueString())
+		if apiError != nil {
+			utils.AddDiagnosticError(resp,
+				ErrRetrievingMonograph,
+				fmt.Sprintf("Could not fetch the schema of monograph '%s': %s", data.Name.ValueString(), apiError.Error()),
+			)
+			return
+		}
+
+		if !data.Schema.IsNull() {
+			// only differences beyond surrounding whitespace count as drift
+			if strings.TrimSpace(sdl.GetSdl()) != strings.TrimSpace(data.Schema.ValueString()) {
+				data.Schema = types.StringValue(sdl.GetSdl())
+				data.SchemaSha256 = schemaSha256Value(sdl.GetSdl())
+			}
+		} else {
+			// the schema file is not stored, its hash is compared instead, it doesn't cover surrounding whitespace either
+			data.SchemaSha256 = schemaSha256Value(sdl.GetSdl())
+		}
 	}
 
 	utils.LogAction(ctx, "read", data.Id.ValueString(), data.Name.ValueString(), data.Namespace.ValueString())
@@ -226,25 +363,36 @@ func (r *MonographResource) Read(ctx context.Context, req resource.ReadRequest,
 }
 
 func (r *MonographResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data MonographResourceModel
+	var data, state MonographResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	schema, loadErr := utils.LoadSchema(data.Schema, data.SchemaFile)
+	if loadErr != nil {
+		utils.AddDiagnosticError(resp, ErrReadingSchemaFile, loadErr.Error())
+		return
+	}
+
+	if data.SchemaSha256.IsUnknown() {
+		data.SchemaSha256 = schemaSha256Value(schema)
+	}
+
 	err := r.client.UpdateMonograph(
 		ctx,
 		data.Name.ValueString(),
 		data.Namespace.ValueString(),
 		data.RoutingURL.ValueString(),
 		data.GraphUrl.ValueString(),
-		utils.StringValueOrNil(data.SubscriptionUrl),
-		utils.StringValueOrNil(data.Readme),
-		data.WebsocketSubprotocol.ValueString(),
-		data.SubscriptionProtocol.ValueString(),
-		data.AdmissionWebhookURL.ValueString(),
-		data.AdmissionWebhookSecret.ValueString(),
+		utils.StringValueForUpdate(data.SubscriptionUrl, state.SubscriptionUrl),
+		utils.StringValueForUpdate(data.Readme, state.Readme),
+		utils.StringValueForUpdate(data.WebsocketSubprotocol, state.WebsocketSubprotocol),
+		utils.StringValueForUpdate(data.SubscriptionProtocol, state.SubscriptionProtocol),
+		utils.StringValueForUpdate(data.AdmissionWebhookURL, state.AdmissionWebhookURL),
+		utils.StringValueForUpdate(data.AdmissionWebhookSecret, state.AdmissionWebhookSecret),
 	)
 	if err != nil {
 		utils.AddDiagnosticError(resp,
@@ -263,6 +411,14 @@ func (r *MonographResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
+	if schema != "" && !data.SchemaSha256.Equal(state.SchemaSha256) {
+		if !r.publishMonographSchema(ctx, resp, data, schema) {
+			// the other fields are updated, keep the previously stored schema so it is published again on the next apply
+			data.Schema = state.Schema
+			data.SchemaSha256 = state.SchemaSha256
+		}
+	}
+
 	data.Id = types.StringValue(monograph.GetId())
 	data.Name = types.StringValue(monograph.GetName())
 
@@ -291,6 +447,36 @@ func (r *MonographResource) Delete(ctx context.Context, req resource.DeleteReque
 	utils.LogAction(ctx, "deleted", data.Id.ValueString(), data.Name.ValueString(), data.Namespace.ValueString())
 }
 
+// publishMonographSchema publishes the schema of the monograph and reports the outcome, it returns whether
+// the schema can be stored in the state. A failed composition is only accepted in `warn` mode, so that the
+// schema is published again on the next apply otherwise.
+func (r *MonographResource) publishMonographSchema(ctx context.Context, resp interface{}, data MonographResourceModel, schema string) bool {
+	_, apiError := r.client.PublishMonograph(ctx, data.Name.ValueString(), data.Namespace.ValueString(), schema)
+	if apiError == nil {
+		return true
+	}
+
+	if api.IsSubgraphCompositionFailedError(apiError) {
+		mode := r.client.ResolveCompositionFailureMode("")
+		utils.AddCompositionDiagnostics(resp, api.CompositionFailureSeverity(mode), ErrCompositionError, apiError.Error(), apiError.CompositionIssues())
+		return mode == api.CompositionFailureModeWarn
+	}
+
+	if api.IsInvalidSubgraphSchemaError(apiError) {
+		utils.AddDiagnosticError(resp, ErrPublishingMonograph, apiError.Error())
+		return false
+	}
+
+	utils.AddCompositionDiagnostics(resp, diag.SeverityError, ErrPublishingMonograph, apiError.Error(), apiError.CompositionIssues())
+	return false
+}
+
 func (r *MonographResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// schemaSha256Value hashes the schema without surrounding whitespace, which the platform doesn't preserve, so
+// that a schema file with a trailing newline doesn't drift from the published schema.
+func schemaSha256Value(schema string) types.String {
+	return utils.SchemaSha256Value(strings.TrimSpace(schema))
+}
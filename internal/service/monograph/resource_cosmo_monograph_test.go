@@ -1,7 +1,12 @@
 package monograph_test
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -63,3 +68,194 @@ resource "cosmo_monograph" "test" {
 }
 `, namespace, name, graphUrl, routingURL)
 }
+
+func TestAccMonographResourceOptionalFields(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-monograph")
+	namespace := acctest.RandomWithPrefix("test-namespace")
+
+	graphUrl := "http://example.com/graphql"
+	routingURL := "http://example.com/routing"
+	webhookURL := "http://example.com/webhook"
+	readme := "Initial readme content"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMonographResourceWithOptionalFieldsConfig(namespace, name, graphUrl, routingURL, webhookURL, readme),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cosmo_monograph.test", "admission_webhook_url", webhookURL),
+					resource.TestCheckResourceAttr("cosmo_monograph.test", "readme", readme),
+				),
+			},
+			{
+				Config: testAccMonographResourceConfig(namespace, name, graphUrl, routingURL),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr("cosmo_monograph.test", "admission_webhook_url"),
+					resource.TestCheckNoResourceAttr("cosmo_monograph.test", "readme"),
+				),
+			},
+			{
+				ResourceName: "cosmo_monograph.test",
+				RefreshState: true,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr("cosmo_monograph.test", "readme"),
+				),
+			},
+			{
+				Config:  testAccMonographResourceConfig(namespace, name, graphUrl, routingURL),
+				Destroy: true,
+			},
+		},
+	})
+}
+
+func testAccMonographResourceWithOptionalFieldsConfig(namespace, name, graphUrl, routingURL, webhookURL, readme string) string {
+	return fmt.Sprintf(`
+resource "cosmo_namespace" "test" {
+  name = "%s"
+}
+
+resource "cosmo_monograph" "test" {
+	name                  = "%s"
+	namespace             = cosmo_namespace.test.name
+	graph_url             = "%s"
+	routing_url           = "%s"
+	admission_webhook_url = "%s"
+	readme                = "%s"
+}
+`, namespace, name, graphUrl, routingURL, webhookURL, readme)
+}
+
+func TestAccMonographResourceSchema(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-monograph")
+	namespace := acctest.RandomWithPrefix("test-namespace")
+
+	graphUrl := "http://example.com/graphql"
+	routingURL := "http://example.com/routing"
+
+	schema := acceptance.TestAccValidSubgraphSchema
+	updatedSchema := "type Query { hello: String }"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMonographResourceWithSchemaConfig(namespace, name, graphUrl, routingURL, schema),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("cosmo_monograph.test", "schema"),
+				),
+			},
+			{
+				Config: testAccMonographResourceWithSchemaConfig(namespace, name, graphUrl, routingURL, updatedSchema),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cosmo_monograph.test", "schema", updatedSchema+"\n"),
+				),
+			},
+			{
+				ResourceName: "cosmo_monograph.test",
+				RefreshState: true,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cosmo_monograph.test", "schema", updatedSchema+"\n"),
+				),
+			},
+			{
+				Config:  testAccMonographResourceWithSchemaConfig(namespace, name, graphUrl, routingURL, updatedSchema),
+				Destroy: true,
+			},
+		},
+	})
+}
+
+func testAccMonographResourceWithSchemaConfig(namespace, name, graphUrl, routingURL, schema string) string {
+	return fmt.Sprintf(`
+resource "cosmo_namespace" "test" {
+  name = "%s"
+}
+
+resource "cosmo_monograph" "test" {
+	name        = "%s"
+	namespace   = cosmo_namespace.test.name
+	graph_url   = "%s"
+	routing_url = "%s"
+	schema      = <<-EOT
+%s
+EOT
+}
+`, namespace, name, graphUrl, routingURL, schema)
+}
+
+func TestAccMonographResourceSchemaFile(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-monograph")
+	namespace := acctest.RandomWithPrefix("test-namespace")
+
+	graphUrl := "http://example.com/graphql"
+	routingURL := "http://example.com/routing"
+
+	schemaFile := filepath.Join(t.TempDir(), "schema.graphql")
+	schema := acceptance.TestAccValidSubgraphSchema
+	updatedSchema := "type Query { hello: String }"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() { writeSchemaFile(t, schemaFile, schema) },
+				Config:    testAccMonographResourceWithSchemaFileConfig(namespace, name, graphUrl, routingURL, schemaFile),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cosmo_monograph.test", "schema_sha256", schemaSha256(schema)),
+					resource.TestCheckNoResourceAttr("cosmo_monograph.test", "schema"),
+				),
+			},
+			{
+				// the trailing newline is not published, it must not show up as drift
+				PreConfig: func() { writeSchemaFile(t, schemaFile, updatedSchema+"\n") },
+				Config:    testAccMonographResourceWithSchemaFileConfig(namespace, name, graphUrl, routingURL, schemaFile),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cosmo_monograph.test", "schema_sha256", schemaSha256(updatedSchema)),
+				),
+			},
+			{
+				ResourceName: "cosmo_monograph.test",
+				RefreshState: true,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cosmo_monograph.test", "schema_sha256", schemaSha256(updatedSchema)),
+				),
+			},
+			{
+				Config:  testAccMonographResourceWithSchemaFileConfig(namespace, name, graphUrl, routingURL, schemaFile),
+				Destroy: true,
+			},
+		},
+	})
+}
+
+func testAccMonographResourceWithSchemaFileConfig(namespace, name, graphUrl, routingURL, schemaFile string) string {
+	return fmt.Sprintf(`
+resource "cosmo_namespace" "test" {
+  name = "%s"
+}
+
+resource "cosmo_monograph" "test" {
+	name        = "%s"
+	namespace   = cosmo_namespace.test.name
+	graph_url   = "%s"
+	routing_url = "%s"
+	schema_file = "%s"
+}
+`, namespace, name, graphUrl, routingURL, schemaFile)
+}
+
+func writeSchemaFile(t *testing.T, path, schema string) {
+	if err := os.WriteFile(path, []byte(schema), 0o600); err != nil {
+		t.Fatalf("could not write schema file: %s", err)
+	}
+}
+
+func schemaSha256(schema string) string {
+	sum := sha256.Sum256([]byte(strings.TrimSpace(schema)))
+	return hex.EncodeToString(sum[:])
+}
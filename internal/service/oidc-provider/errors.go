@@ -0,0 +1,9 @@
+package oidc_provider
+
+const (
+	ErrCreatingOIDCProvider     = "Error Creating OIDC Provider"
+	ErrReadingOIDCProvider      = "Error Reading OIDC Provider"
+	ErrDeletingOIDCProvider     = "Error Deleting OIDC Provider"
+	ErrOIDCProviderNotFound     = "OIDC Provider Not Found"
+	ErrUnexpectedDataSourceType = "Unexpected Data Source Configure Type"
+)
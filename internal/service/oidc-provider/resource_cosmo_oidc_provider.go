@@ -0,0 +1,309 @@
+package oidc_provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	platformv1 "github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/platform/v1"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/api"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &OIDCProviderResource{}
+var _ resource.ResourceWithImportState = &OIDCProviderResource{}
+
+type OIDCProviderResource struct {
+	client *api.PlatformClient
+}
+
+type OIDCProviderResourceModel struct {
+	Id                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	DiscoveryEndpoint  types.String `tfsdk:"discovery_endpoint"`
+	ClientId           types.String `tfsdk:"client_id"`
+	ClientSecret       types.String `tfsdk:"client_secret"`
+	Mappers            types.Set    `tfsdk:"mappers"`
+	Endpoint           types.String `tfsdk:"endpoint"`
+	LoginURL           types.String `tfsdk:"login_url"`
+	SignInRedirectURL  types.String `tfsdk:"sign_in_redirect_url"`
+	SignOutRedirectURL types.String `tfsdk:"sign_out_redirect_url"`
+}
+
+type OIDCProviderMapperModel struct {
+	Role     types.String `tfsdk:"role"`
+	SsoGroup types.String `tfsdk:"sso_group"`
+}
+
+func NewOIDCProviderResource() resource.Resource {
+	return &OIDCProviderResource{}
+}
+
+func (r *OIDCProviderResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_oidc_provider"
+}
+
+func (r *OIDCProviderResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Connects an OIDC identity provider to the organization to enable single sign-on. An organization can only have one OIDC provider, and the platform does not support updating it, so any change recreates the provider.
+
+The client secret is only sent when the provider is created and is never read back from the platform. The platform does not return the client ID and group mappers either, so drift is detected on the name and endpoint of the provider, and on its removal.
+
+For more information on single sign-on, please refer to the [Cosmo Documentation](https://cosmo-docs.wundergraph.com/studio/sso).
+		`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the OIDC provider resource.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the OIDC provider.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"discovery_endpoint": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The OIDC discovery endpoint of the identity provider, e.g. `https://idp.example.com/.well-known/openid-configuration`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"client_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The client ID of the application registered in the identity provider.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"client_secret": schema.StringAttribute{
+				Required:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The client secret of the application registered in the identity provider. It is write-only: the platform never returns it.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"mappers": schema.SetNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Maps groups of the identity provider to organization roles.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"role": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The organization role granted to members of the group.",
+							Validators: []validator.String{
+								stringvalidator.OneOf(api.OrganizationRoleAdmin, api.OrganizationRoleDeveloper, api.OrganizationRoleViewer),
+							},
+						},
+						"sso_group": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The group claim value of the identity provider.",
+						},
+					},
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"endpoint": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The endpoint of the identity provider as stored by the platform.",
+			},
+			"login_url": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The URL members use to sign in through the identity provider.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sign_in_redirect_url": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The sign-in redirect URL to register in the identity provider.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sign_out_redirect_url": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The sign-out redirect URL to register in the identity provider.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *OIDCProviderResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.PlatformClient)
+	if !ok {
+		utils.AddDiagnosticError(resp,
+			ErrUnexpectedDataSourceType,
+			fmt.Sprintf("Expected *api.PlatformClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *OIDCProviderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data OIDCProviderResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var mappers []OIDCProviderMapperModel
+	if !data.Mappers.IsNull() && !data.Mappers.IsUnknown() {
+		resp.Diagnostics.Append(data.Mappers.ElementsAs(ctx, &mappers, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	var groupMappers []*platformv1.GroupMapper
+	for _, mapper := range mappers {
+		groupMappers = append(groupMappers, &platformv1.GroupMapper{
+			Role:     mapper.Role.ValueString(),
+			SsoGroup: mapper.SsoGroup.ValueString(),
+		})
+	}
+
+	created, apiError := r.client.CreateOIDCProvider(ctx, data.Name.ValueString(), data.DiscoveryEndpoint.ValueString(), data.ClientId.ValueString(), data.ClientSecret.ValueString(), groupMappers)
+	if apiError != nil {
+		utils.AddDiagnosticError(resp,
+			ErrCreatingOIDCProvider,
+			apiError.Error(),
+		)
+		return
+	}
+
+	provider, apiError := r.client.GetOIDCProvider(ctx)
+	if apiError != nil {
+		utils.AddDiagnosticError(resp,
+			ErrReadingOIDCProvider,
+			apiError.Error(),
+		)
+		return
+	}
+
+	data.Id = types.StringValue(provider.GetName())
+	data.Endpoint = types.StringValue(provider.GetEndpoint())
+	data.LoginURL = types.StringValue(created.GetLoginURL())
+	data.SignInRedirectURL = types.StringValue(created.GetSignInURL())
+	data.SignOutRedirectURL = types.StringValue(created.GetSignOutURL())
+
+	utils.LogAction(ctx, "created", data.Id.ValueString(), data.Name.ValueString(), "")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OIDCProviderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data OIDCProviderResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	provider, apiError := r.client.GetOIDCProvider(ctx)
+	if apiError != nil {
+		if api.IsNotFoundError(apiError) {
+			utils.AddDiagnosticWarning(resp,
+				ErrOIDCProviderNotFound,
+				fmt.Sprintf("OIDC provider '%s' not found will be recreated %s", data.Name.ValueString(), apiError.Error()),
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		utils.AddDiagnosticError(resp, ErrReadingOIDCProvider, apiError.Error())
+		return
+	}
+
+	data.Id = types.StringValue(provider.GetName())
+	data.Name = types.StringValue(provider.GetName())
+	data.Endpoint = types.StringValue(provider.GetEndpoint())
+	data.LoginURL = types.StringValue(provider.GetLoginURL())
+	data.SignInRedirectURL = types.StringValue(provider.GetSignInRedirectURL())
+	data.SignOutRedirectURL = types.StringValue(provider.GetSignOutRedirectURL())
+
+	// The platform only stores the endpoint derived from the discovery endpoint,
+	// the configured value is kept as long as it still points to the same host.
+	if data.DiscoveryEndpoint.IsNull() || !matchesDiscoveryEndpoint(data.DiscoveryEndpoint.ValueString(), provider.GetEndpoint()) {
+		data.DiscoveryEndpoint = types.StringValue(provider.GetEndpoint())
+	}
+
+	utils.LogAction(ctx, "read", data.Id.ValueString(), data.Name.ValueString(), "")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OIDCProviderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// every attribute requires replacement, the platform does not support updating an OIDC provider
+}
+
+func (r *OIDCProviderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data OIDCProviderResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiError := r.client.DeleteOIDCProvider(ctx)
+	if apiError != nil {
+		if api.IsNotFoundError(apiError) {
+			utils.AddDiagnosticWarning(resp,
+				ErrDeletingOIDCProvider,
+				apiError.Error(),
+			)
+			return
+		}
+		utils.AddDiagnosticError(resp,
+			ErrDeletingOIDCProvider,
+			apiError.Error(),
+		)
+		return
+	}
+
+	utils.LogAction(ctx, "deleted", data.Id.ValueString(), data.Name.ValueString(), "")
+}
+
+func (r *OIDCProviderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func matchesDiscoveryEndpoint(discoveryEndpoint, endpoint string) bool {
+	if discoveryEndpoint == endpoint {
+		return true
+	}
+
+	parsed, err := url.Parse(discoveryEndpoint)
+	if err != nil {
+		return false
+	}
+
+	return parsed.Host == endpoint || parsed.Hostname() == endpoint
+}
@@ -0,0 +1,65 @@
+package oidc_provider_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/acceptance"
+)
+
+func TestAccOIDCProviderResource(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-oidc-provider")
+
+	// the keycloak realm of the local cosmo demo setup
+	discoveryEndpoint := "http://localhost:8080/realms/cosmo/.well-known/openid-configuration"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOIDCProviderResourceConfig(name, discoveryEndpoint, "developers"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cosmo_oidc_provider.test", "name", name),
+					resource.TestCheckResourceAttr("cosmo_oidc_provider.test", "discovery_endpoint", discoveryEndpoint),
+					resource.TestCheckResourceAttr("cosmo_oidc_provider.test", "mappers.#", "2"),
+					resource.TestCheckResourceAttrSet("cosmo_oidc_provider.test", "login_url"),
+				),
+			},
+			{
+				Config: testAccOIDCProviderResourceConfig(name, discoveryEndpoint, "engineering"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cosmo_oidc_provider.test", "mappers.#", "2"),
+				),
+			},
+			{
+				Config:  testAccOIDCProviderResourceConfig(name, discoveryEndpoint, "engineering"),
+				Destroy: true,
+			},
+		},
+	})
+}
+
+func testAccOIDCProviderResourceConfig(name, discoveryEndpoint, developerGroup string) string {
+	return fmt.Sprintf(`
+resource "cosmo_oidc_provider" "test" {
+  name               = "%s"
+  discovery_endpoint = "%s"
+  client_id          = "studio"
+  client_secret      = "secret"
+
+  mappers = [
+    {
+      role      = "admin"
+      sso_group = "admins"
+    },
+    {
+      role      = "developer"
+      sso_group = "%s"
+    },
+  ]
+}
+`, name, discoveryEndpoint, developerGroup)
+}
@@ -0,0 +1,10 @@
+package persisted_operations
+
+const (
+	ErrPublishingPersistedOperations = "Error Publishing Persisted Operations"
+	ErrReadingPersistedOperations    = "Error Reading Persisted Operations"
+	ErrLoadingOperations             = "Error Loading Operations"
+	ErrPersistedOperationConflict    = "Persisted Operation Conflict"
+	ErrClientNotFound                = "Client Not Found"
+	ErrUnexpectedDataSourceType      = "Unexpected Data Source Configure Type"
+)
@@ -0,0 +1,119 @@
+package persisted_operations
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// operationFileExtensions are the file extensions picked up when loading operations from a directory.
+var operationFileExtensions = []string{".graphql", ".gql"}
+
+type operationDocument struct {
+	Hash     string
+	Contents string
+}
+
+// hashOperation returns the identifier of an operation, the sha256 of its contents, which
+// matches the identifier `wgc operations push` assigns to plain GraphQL documents.
+func hashOperation(contents string) string {
+	sum := sha256.Sum256([]byte(contents))
+	return hex.EncodeToString(sum[:])
+}
+
+// loadOperationDocuments collects the operation documents either from the inline list or from the
+// files of the directory, in a deterministic order and without duplicates.
+func loadOperationDocuments(operations types.List, directory types.String) ([]operationDocument, error) {
+	var contents []string
+
+	if !directory.IsNull() && directory.ValueString() != "" {
+		files, err := readOperationFiles(directory.ValueString())
+		if err != nil {
+			return nil, err
+		}
+		contents = files
+	} else {
+		for _, operation := range operations.Elements() {
+			strVal, ok := operation.(types.String)
+			if !ok {
+				return nil, fmt.Errorf("expected string type in operations, got: %T", operation)
+			}
+			contents = append(contents, strVal.ValueString())
+		}
+	}
+
+	var documents []operationDocument
+	seen := make(map[string]bool)
+	for _, content := range contents {
+		if strings.TrimSpace(content) == "" {
+			continue
+		}
+
+		hash := hashOperation(content)
+		if seen[hash] {
+			continue
+		}
+		seen[hash] = true
+
+		documents = append(documents, operationDocument{Hash: hash, Contents: content})
+	}
+
+	if len(documents) == 0 {
+		return nil, fmt.Errorf("no operations found")
+	}
+
+	return documents, nil
+}
+
+func operationHashesValue(documents []operationDocument) types.List {
+	var hashes []attr.Value
+	for _, document := range documents {
+		hashes = append(hashes, types.StringValue(document.Hash))
+	}
+	return types.ListValueMust(types.StringType, hashes)
+}
+
+func readOperationFiles(directory string) ([]string, error) {
+	var contents []string
+
+	// WalkDir visits the files in lexical order, which keeps the operation order stable between runs
+	err := filepath.WalkDir(directory, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if entry.IsDir() || !isOperationFile(path) {
+			return nil
+		}
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("could not read operation file '%s': %w", path, err)
+		}
+		contents = append(contents, string(content))
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return contents, nil
+}
+
+func isOperationFile(path string) bool {
+	extension := strings.ToLower(filepath.Ext(path))
+	for _, operationExtension := range operationFileExtensions {
+		if extension == operationExtension {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,87 @@
+package persisted_operations
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestHashOperation(t *testing.T) {
+	hash := hashOperation("query { hello }")
+	if hash != "ec2e01311ab3b02f3d8c8c712f9e579356d332cd007ac4c1ea5df727f482f05f" {
+		t.Errorf("Expected the sha256 hex digest of the operation, got: %s", hash)
+	}
+
+	if hash == hashOperation("query { world }") {
+		t.Errorf("Expected different operations to have different hashes")
+	}
+}
+
+func TestLoadOperationDocumentsFromList(t *testing.T) {
+	operations := types.ListValueMust(types.StringType, []attr.Value{
+		types.StringValue("query A { a }"),
+		types.StringValue("query B { b }"),
+		types.StringValue("query A { a }"),
+	})
+
+	documents, err := loadOperationDocuments(operations, types.StringNull())
+	if err != nil {
+		t.Fatalf("Expected operations to be loaded, got error: %v", err)
+	}
+
+	if len(documents) != 2 {
+		t.Fatalf("Expected duplicated operations to be removed, got %d documents", len(documents))
+	}
+
+	if documents[0].Contents != "query A { a }" || documents[1].Contents != "query B { b }" {
+		t.Errorf("Expected operations to keep their order, got: %v", documents)
+	}
+}
+
+func TestLoadOperationDocumentsFromDirectory(t *testing.T) {
+	directory := t.TempDir()
+	files := map[string]string{
+		"b.graphql":        "query B { b }",
+		"a.gql":            "query A { a }",
+		"nested/c.graphql": "query C { c }",
+		"README.md":        "not an operation",
+	}
+	for name, content := range files {
+		path := filepath.Join(directory, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	documents, err := loadOperationDocuments(types.ListNull(types.StringType), types.StringValue(directory))
+	if err != nil {
+		t.Fatalf("Expected operations to be loaded, got error: %v", err)
+	}
+
+	expected := []string{"query A { a }", "query B { b }", "query C { c }"}
+	if len(documents) != len(expected) {
+		t.Fatalf("Expected %d documents, got %d", len(expected), len(documents))
+	}
+
+	for i, document := range documents {
+		if document.Contents != expected[i] {
+			t.Errorf("Expected document %d to be %q, got %q", i, expected[i], document.Contents)
+		}
+		if document.Hash != hashOperation(expected[i]) {
+			t.Errorf("Expected document %d to be identified by its hash", i)
+		}
+	}
+}
+
+func TestLoadOperationDocumentsEmptyDirectory(t *testing.T) {
+	_, err := loadOperationDocuments(types.ListNull(types.StringType), types.StringValue(t.TempDir()))
+	if err == nil {
+		t.Errorf("Expected an error for a directory without operations")
+	}
+}
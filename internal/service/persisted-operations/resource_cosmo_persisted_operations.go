@@ -0,0 +1,368 @@
+package persisted_operations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	platformv1 "github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/platform/v1"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/api"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PersistedOperationsResource{}
+var _ resource.ResourceWithModifyPlan = &PersistedOperationsResource{}
+
+var publishedOperationAttributeTypes = map[string]attr.Type{
+	"id":              types.StringType,
+	"status":          types.StringType,
+	"operation_names": types.ListType{ElemType: types.StringType},
+}
+
+type PersistedOperationsResource struct {
+	client *api.PlatformClient
+}
+
+type PersistedOperationsResourceModel struct {
+	Id                  types.String `tfsdk:"id"`
+	FederatedGraph      types.String `tfsdk:"federated_graph"`
+	Namespace           types.String `tfsdk:"namespace"`
+	ClientName          types.String `tfsdk:"client_name"`
+	Operations          types.List   `tfsdk:"operations"`
+	Directory           types.String `tfsdk:"directory"`
+	OperationHashes     types.List   `tfsdk:"operation_hashes"`
+	PublishedOperations types.List   `tfsdk:"published_operations"`
+}
+
+func NewPersistedOperationsResource() resource.Resource {
+	return &PersistedOperationsResource{}
+}
+
+func (r *PersistedOperationsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_persisted_operations"
+}
+
+func (r *PersistedOperationsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Publishes persisted operations (trusted documents) of a client to a federated graph, like ` + "`wgc operations push`" + ` does.
+
+The operations are identified by the sha256 hash of their contents, computed by the provider. They are republished whenever the set of hashes changes, including when files of the configured directory change. Persisted operations cannot be deleted through the platform API, destroying the resource only removes it from the state.
+
+For more information on persisted operations, please refer to the [Cosmo Documentation](https://cosmo-docs.wundergraph.com/router/persisted-queries/persisted-operations).
+		`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the persisted operations resource.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"federated_graph": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the federated graph the operations are published to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "The namespace in which the federated graph is located.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("default"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"client_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the client the operations belong to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"operations": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The operation documents to publish. Exactly one of `operations` or `directory` must be set.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ExactlyOneOf(path.MatchRoot("directory")),
+				},
+			},
+			"directory": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "A directory whose `.graphql` and `.gql` files, including those of nested directories, are published as operations.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"operation_hashes": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The sha256 hashes of the published operations, used as their identifiers.",
+			},
+			"published_operations": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The result of the last publish for each operation.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The identifier of the operation.",
+						},
+						"status": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: fmt.Sprintf("The publish status of the operation, one of `%s`, `%s` or `%s`.", api.PublishedOperationStatusCreated, api.PublishedOperationStatusUpToDate, api.PublishedOperationStatusConflict),
+						},
+						"operation_names": schema.ListAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "The names of the operations defined in the document.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *PersistedOperationsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.PlatformClient)
+	if !ok {
+		utils.AddDiagnosticError(resp,
+			ErrUnexpectedDataSourceType,
+			fmt.Sprintf("Expected *api.PlatformClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan computes the operation hashes from the configuration so that changes to the files
+// of the directory show up in the plan.
+func (r *PersistedOperationsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan PersistedOperationsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Operations.IsUnknown() || plan.Directory.IsUnknown() {
+		return
+	}
+
+	for _, operation := range plan.Operations.Elements() {
+		if operation.IsUnknown() {
+			return
+		}
+	}
+
+	documents, err := loadOperationDocuments(plan.Operations, plan.Directory)
+	if err != nil {
+		resp.Diagnostics.AddError(ErrLoadingOperations, err.Error())
+		return
+	}
+
+	hashes := operationHashesValue(documents)
+	plan.OperationHashes = hashes
+	plan.PublishedOperations = types.ListUnknown(types.ObjectType{AttrTypes: publishedOperationAttributeTypes})
+
+	if !req.State.Raw.IsNull() {
+		var state PersistedOperationsResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if state.OperationHashes.Equal(hashes) {
+			plan.PublishedOperations = state.PublishedOperations
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *PersistedOperationsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PersistedOperationsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.publishOperations(ctx, &data, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%s/%s/%s", data.Namespace.ValueString(), data.FederatedGraph.ValueString(), data.ClientName.ValueString()))
+
+	utils.LogAction(ctx, "created", data.Id.ValueString(), data.ClientName.ValueString(), data.Namespace.ValueString())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PersistedOperationsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PersistedOperationsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clients, apiError := r.client.GetClients(ctx, data.FederatedGraph.ValueString(), data.Namespace.ValueString())
+	if apiError != nil {
+		if api.IsNotFoundError(apiError) {
+			utils.AddDiagnosticWarning(resp,
+				ErrClientNotFound,
+				apiError.Error(),
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		utils.AddDiagnosticError(resp, ErrReadingPersistedOperations, apiError.Error())
+		return
+	}
+
+	var client *platformv1.ClientInfo
+	for _, c := range clients {
+		if c.GetName() == data.ClientName.ValueString() {
+			client = c
+			break
+		}
+	}
+
+	if client == nil {
+		utils.AddDiagnosticWarning(resp,
+			ErrClientNotFound,
+			fmt.Sprintf("Client '%s' not found, the operations will be published again", data.ClientName.ValueString()),
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	operations, apiError := r.client.GetPersistedOperations(ctx, data.FederatedGraph.ValueString(), data.Namespace.ValueString(), client.GetId())
+	if apiError != nil {
+		utils.AddDiagnosticError(resp, ErrReadingPersistedOperations, apiError.Error())
+		return
+	}
+
+	published := make(map[string]bool, len(operations))
+	for _, operation := range operations {
+		published[operation.GetId()] = true
+	}
+
+	// Only keep the hashes that are still published, a missing operation is published again on the next apply.
+	var hashes []attr.Value
+	for _, hash := range data.OperationHashes.Elements() {
+		strVal, ok := hash.(types.String)
+		if ok && published[strVal.ValueString()] {
+			hashes = append(hashes, strVal)
+		}
+	}
+	data.OperationHashes = types.ListValueMust(types.StringType, hashes)
+
+	utils.LogAction(ctx, "read", data.Id.ValueString(), data.ClientName.ValueString(), data.Namespace.ValueString())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PersistedOperationsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data PersistedOperationsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.publishOperations(ctx, &data, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	utils.LogAction(ctx, "updated", data.Id.ValueString(), data.ClientName.ValueString(), data.Namespace.ValueString())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PersistedOperationsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PersistedOperationsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// persisted operations cannot be deleted through the platform API, they are only removed from the state
+	utils.LogAction(ctx, "deleted", data.Id.ValueString(), data.ClientName.ValueString(), data.Namespace.ValueString())
+}
+
+func (r *PersistedOperationsResource) publishOperations(ctx context.Context, data *PersistedOperationsResourceModel, resp interface{}) {
+	documents, err := loadOperationDocuments(data.Operations, data.Directory)
+	if err != nil {
+		utils.AddDiagnosticError(resp, ErrLoadingOperations, err.Error())
+		return
+	}
+
+	var operations []*platformv1.PersistedOperation
+	for _, document := range documents {
+		operations = append(operations, &platformv1.PersistedOperation{
+			Id:       document.Hash,
+			Contents: document.Contents,
+		})
+	}
+
+	published, apiError := r.client.PublishPersistedOperations(ctx, data.FederatedGraph.ValueString(), data.Namespace.ValueString(), data.ClientName.ValueString(), operations)
+	if apiError != nil {
+		utils.AddDiagnosticError(resp, ErrPublishingPersistedOperations, apiError.Error())
+		return
+	}
+
+	var publishedOperations []attr.Value
+	for _, operation := range published {
+		status := api.ResolvePublishedOperationStatus(operation.GetStatus())
+		if status == api.PublishedOperationStatusConflict {
+			utils.AddDiagnosticWarning(resp,
+				ErrPersistedOperationConflict,
+				fmt.Sprintf("Operation '%s' conflicts with an already published operation of client '%s'", operation.GetId(), data.ClientName.ValueString()),
+			)
+		}
+
+		var operationNames []attr.Value
+		for _, name := range operation.GetOperationNames() {
+			operationNames = append(operationNames, types.StringValue(name))
+		}
+
+		publishedOperations = append(publishedOperations, types.ObjectValueMust(publishedOperationAttributeTypes, map[string]attr.Value{
+			"id":              types.StringValue(operation.GetId()),
+			"status":          types.StringValue(status),
+			"operation_names": types.ListValueMust(types.StringType, operationNames),
+		}))
+	}
+
+	data.OperationHashes = operationHashesValue(documents)
+	data.PublishedOperations = types.ListValueMust(types.ObjectType{AttrTypes: publishedOperationAttributeTypes}, publishedOperations)
+}
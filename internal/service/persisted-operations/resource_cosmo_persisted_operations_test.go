@@ -0,0 +1,80 @@
+package persisted_operations_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/acceptance"
+)
+
+func TestAccPersistedOperationsResource(t *testing.T) {
+	namespace := acctest.RandomWithPrefix("test-namespace")
+	federatedGraphName := acctest.RandomWithPrefix("test-federated-graph")
+	subgraphName := acctest.RandomWithPrefix("test-subgraph")
+	clientName := acctest.RandomWithPrefix("test-client")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPersistedOperationsResourceConfig(namespace, federatedGraphName, subgraphName, clientName, `"query Company { company { ceo } }"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cosmo_persisted_operations.test", "client_name", clientName),
+					resource.TestCheckResourceAttr("cosmo_persisted_operations.test", "operation_hashes.#", "1"),
+					resource.TestCheckResourceAttr("cosmo_persisted_operations.test", "published_operations.#", "1"),
+					resource.TestCheckResourceAttr("cosmo_persisted_operations.test", "published_operations.0.status", "created"),
+					resource.TestCheckResourceAttr("cosmo_persisted_operations.test", "published_operations.0.operation_names.0", "Company"),
+				),
+			},
+			{
+				Config: testAccPersistedOperationsResourceConfig(namespace, federatedGraphName, subgraphName, clientName, `"query Company { company { ceo } }", "query Dragons { dragons { name } }"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cosmo_persisted_operations.test", "operation_hashes.#", "2"),
+					resource.TestCheckResourceAttr("cosmo_persisted_operations.test", "published_operations.0.status", "up_to_date"),
+					resource.TestCheckResourceAttr("cosmo_persisted_operations.test", "published_operations.1.status", "created"),
+				),
+			},
+			{
+				Config:  testAccPersistedOperationsResourceConfig(namespace, federatedGraphName, subgraphName, clientName, `"query Company { company { ceo } }"`),
+				Destroy: true,
+			},
+		},
+	})
+}
+
+func testAccPersistedOperationsResourceConfig(namespace, federatedGraphName, subgraphName, clientName, operations string) string {
+	return fmt.Sprintf(`
+resource "cosmo_namespace" "test" {
+  name = "%s"
+}
+
+resource "cosmo_federated_graph" "test" {
+  name           = "%s"
+  namespace      = cosmo_namespace.test.name
+  routing_url    = "https://federated-graph-persisted-operations-example.com"
+  label_matchers = ["team=backend"]
+
+  depends_on = [cosmo_subgraph.test]
+}
+
+resource "cosmo_subgraph" "test" {
+  name        = "%s"
+  namespace   = cosmo_namespace.test.name
+  routing_url = "https://subgraph-persisted-operations-example.com"
+  labels      = { "team" = "backend" }
+  schema      = <<-EOT
+  %s
+  EOT
+}
+
+resource "cosmo_persisted_operations" "test" {
+  federated_graph = cosmo_federated_graph.test.name
+  namespace       = cosmo_namespace.test.name
+  client_name     = "%s"
+  operations      = [%s]
+}
+`, namespace, federatedGraphName, subgraphName, acceptance.TestAccValidSubgraphSchema, clientName, operations)
+}
@@ -0,0 +1,135 @@
+package router_config
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/api"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &RouterConfigDataSource{}
+
+func NewRouterConfigDataSource() datasource.DataSource {
+	return &RouterConfigDataSource{}
+}
+
+// RouterConfigDataSource defines the data source implementation.
+type RouterConfigDataSource struct {
+	client *api.PlatformClient
+}
+
+// RouterConfigDataSourceModel describes the data source data model.
+type RouterConfigDataSourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Namespace     types.String `tfsdk:"namespace"`
+	Config        types.String `tfsdk:"config"`
+	VersionId     types.String `tfsdk:"version_id"`
+	CompositionId types.String `tfsdk:"composition_id"`
+}
+
+// Metadata returns the data source type name.
+func (d *RouterConfigDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_router_config"
+}
+
+// Schema defines the schema for the data source.
+func (d *RouterConfigDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Cosmo Router Config Data Source. Fetches the router execution config of the latest valid composition of a federated graph or contract, e.g. to run routers without access to the Cosmo CDN.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the router config, in the format `<namespace>/<name>`.",
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the federated graph or contract.",
+			},
+			"namespace": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The namespace in which the federated graph or contract is located. Defaults to 'default'.",
+			},
+			"config": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The router execution config as a JSON string.",
+			},
+			"version_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The version of the router execution config.",
+			},
+			"composition_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the composition the router execution config was built from, null when that composition is older than 7 days.",
+			},
+		},
+	}
+}
+
+// Configure prepares the data source for reading.
+func (d *RouterConfigDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.PlatformClient)
+	if !ok {
+		utils.AddDiagnosticError(resp,
+			ErrUnexpectedDataSourceType,
+			fmt.Sprintf("Expected *api.PlatformClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the data source data.
+func (d *RouterConfigDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RouterConfigDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Name.IsNull() || data.Name.ValueString() == "" {
+		utils.AddDiagnosticError(resp,
+			ErrInvalidFederatedGraphName,
+			"The 'name' attribute is required.",
+		)
+		return
+	}
+
+	namespace := data.Namespace.ValueString()
+	if namespace == "" {
+		namespace = "default"
+	}
+
+	routerConfig, apiError := d.client.GetLatestValidRouterConfig(ctx, data.Name.ValueString(), namespace)
+	if apiError != nil {
+		utils.AddDiagnosticError(resp,
+			ErrReadingRouterConfig,
+			fmt.Sprintf("Could not read router config for graph '%s' in namespace '%s': %s", data.Name.ValueString(), namespace, apiError.Error()),
+		)
+		return
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%s/%s", namespace, data.Name.ValueString()))
+	data.Namespace = types.StringValue(namespace)
+	data.Config = types.StringValue(routerConfig.Config)
+	data.VersionId = types.StringValue(routerConfig.VersionId)
+	data.CompositionId = types.StringNull()
+	if routerConfig.CompositionId != "" {
+		data.CompositionId = types.StringValue(routerConfig.CompositionId)
+	}
+
+	utils.LogAction(ctx, "read", data.Id.ValueString(), data.Name.ValueString(), namespace)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,65 @@
+package router_config_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/acceptance"
+)
+
+func TestAccRouterConfigDataSource(t *testing.T) {
+	namespace := acctest.RandomWithPrefix("test-namespace")
+	federatedGraphName := acctest.RandomWithPrefix("test-federated-graph")
+	subgraphName := acctest.RandomWithPrefix("test-subgraph")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRouterConfigDataSourceConfig(namespace, federatedGraphName, subgraphName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.cosmo_router_config.test", "name", federatedGraphName),
+					resource.TestCheckResourceAttr("data.cosmo_router_config.test", "namespace", namespace),
+					resource.TestCheckResourceAttrSet("data.cosmo_router_config.test", "config"),
+					resource.TestCheckResourceAttrSet("data.cosmo_router_config.test", "version_id"),
+					resource.TestCheckResourceAttrSet("data.cosmo_router_config.test", "composition_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRouterConfigDataSourceConfig(namespace, federatedGraphName, subgraphName string) string {
+	return fmt.Sprintf(`
+resource "cosmo_namespace" "test" {
+  name = "%s"
+}
+
+resource "cosmo_federated_graph" "test" {
+  name           = "%s"
+  namespace      = cosmo_namespace.test.name
+  routing_url    = "https://example.com"
+  label_matchers = ["team=backend"]
+
+  depends_on = [cosmo_subgraph.test]
+}
+
+resource "cosmo_subgraph" "test" {
+  name        = "%s"
+  namespace   = cosmo_namespace.test.name
+  routing_url = "https://subgraph-router-config-example.com"
+  schema      = "type Query { hello: String }"
+  labels      = {
+    "team" = "backend"
+  }
+}
+
+data "cosmo_router_config" "test" {
+  name      = cosmo_federated_graph.test.name
+  namespace = cosmo_federated_graph.test.namespace
+}
+`, namespace, federatedGraphName, subgraphName)
+}
@@ -0,0 +1,7 @@
+package router_config
+
+const (
+	ErrInvalidFederatedGraphName = "Invalid Federated Graph Name"
+	ErrReadingRouterConfig       = "Error Reading Router Config"
+	ErrUnexpectedDataSourceType  = "Unexpected Data Source Configure Type"
+)
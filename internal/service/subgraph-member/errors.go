@@ -0,0 +1,10 @@
+package subgraph_member
+
+const (
+	ErrAddingSubgraphMember     = "Error Adding Subgraph Member"
+	ErrReadingSubgraphMember    = "Error Reading Subgraph Member"
+	ErrRemovingSubgraphMember   = "Error Removing Subgraph Member"
+	ErrSubgraphMemberNotFound   = "Subgraph Member Not Found"
+	ErrInvalidImportID          = "Invalid Import ID"
+	ErrUnexpectedDataSourceType = "Unexpected Data Source Configure Type"
+)
@@ -0,0 +1,236 @@
+package subgraph_member
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	common "github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/common"
+	platformv1 "github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/platform/v1"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/api"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SubgraphMemberResource{}
+var _ resource.ResourceWithImportState = &SubgraphMemberResource{}
+
+type SubgraphMemberResource struct {
+	client *api.PlatformClient
+}
+
+type SubgraphMemberResourceModel struct {
+	Id        types.String `tfsdk:"id"`
+	Subgraph  types.String `tfsdk:"subgraph"`
+	Namespace types.String `tfsdk:"namespace"`
+	Email     types.String `tfsdk:"email"`
+	UserId    types.String `tfsdk:"user_id"`
+}
+
+func NewSubgraphMemberResource() resource.Resource {
+	return &SubgraphMemberResource{}
+}
+
+func (r *SubgraphMemberResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subgraph_member"
+}
+
+func (r *SubgraphMemberResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Grants an organization member the right to publish a subgraph. Once a subgraph has members, only those members (and organization admins) can publish its schema.
+
+For more information on subgraph members, please refer to the [Cosmo Documentation](https://cosmo-docs.wundergraph.com/studio/subgraph-access-controls).
+		`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the subgraph member.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"subgraph": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the subgraph the member is added to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "The namespace in which the subgraph is located.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("default"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"email": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The email of the organization member allowed to publish the subgraph.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the user behind the member.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *SubgraphMemberResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.PlatformClient)
+	if !ok {
+		utils.AddDiagnosticError(resp,
+			ErrUnexpectedDataSourceType,
+			fmt.Sprintf("Expected *api.PlatformClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SubgraphMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SubgraphMemberResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiError := r.client.AddSubgraphMember(ctx, data.Subgraph.ValueString(), data.Namespace.ValueString(), data.Email.ValueString())
+	if apiError != nil {
+		utils.AddDiagnosticError(resp,
+			ErrAddingSubgraphMember,
+			apiError.Error(),
+		)
+		return
+	}
+
+	member, apiError := getSubgraphMemberByEmail(ctx, r.client, data.Subgraph.ValueString(), data.Namespace.ValueString(), data.Email.ValueString())
+	if apiError != nil {
+		utils.AddDiagnosticError(resp,
+			ErrReadingSubgraphMember,
+			apiError.Error(),
+		)
+		return
+	}
+
+	data.Id = types.StringValue(member.GetSubgraphMemberId())
+	data.UserId = types.StringValue(member.GetUserId())
+
+	utils.LogAction(ctx, "created", data.Id.ValueString(), data.Subgraph.ValueString(), data.Namespace.ValueString())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SubgraphMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SubgraphMemberResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	member, apiError := getSubgraphMemberByEmail(ctx, r.client, data.Subgraph.ValueString(), data.Namespace.ValueString(), data.Email.ValueString())
+	if apiError != nil {
+		if api.IsNotFoundError(apiError) {
+			utils.AddDiagnosticWarning(resp,
+				ErrSubgraphMemberNotFound,
+				fmt.Sprintf("Subgraph member '%s' not found will be recreated %s", data.Email.ValueString(), apiError.Error()),
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		utils.AddDiagnosticError(resp, ErrReadingSubgraphMember, apiError.Error())
+		return
+	}
+
+	data.Id = types.StringValue(member.GetSubgraphMemberId())
+	data.Email = types.StringValue(member.GetEmail())
+	data.UserId = types.StringValue(member.GetUserId())
+
+	utils.LogAction(ctx, "read", data.Id.ValueString(), data.Subgraph.ValueString(), data.Namespace.ValueString())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SubgraphMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// every attribute requires replacement, a subgraph member is never updated in place
+}
+
+func (r *SubgraphMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SubgraphMemberResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiError := r.client.RemoveSubgraphMember(ctx, data.Subgraph.ValueString(), data.Namespace.ValueString(), data.Id.ValueString())
+	if apiError != nil {
+		if api.IsNotFoundError(apiError) {
+			utils.AddDiagnosticWarning(resp,
+				ErrRemovingSubgraphMember,
+				apiError.Error(),
+			)
+			return
+		}
+		utils.AddDiagnosticError(resp,
+			ErrRemovingSubgraphMember,
+			apiError.Error(),
+		)
+		return
+	}
+
+	utils.LogAction(ctx, "deleted", data.Id.ValueString(), data.Subgraph.ValueString(), data.Namespace.ValueString())
+}
+
+// ImportState expects an identifier in the form <namespace>/<subgraph>/<email>.
+func (r *SubgraphMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, "/", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		utils.AddDiagnosticError(resp,
+			ErrInvalidImportID,
+			fmt.Sprintf("Expected import identifier with format <namespace>/<subgraph>/<email>, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("subgraph"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("email"), parts[2])...)
+}
+
+func getSubgraphMemberByEmail(ctx context.Context, client *api.PlatformClient, subgraphName, namespace, email string) (*platformv1.SubgraphMember, *api.ApiError) {
+	members, apiError := client.GetSubgraphMembers(ctx, subgraphName, namespace)
+	if apiError != nil {
+		return nil, apiError
+	}
+
+	for _, member := range members {
+		if strings.EqualFold(member.GetEmail(), email) {
+			return member, nil
+		}
+	}
+
+	return nil, api.NewApiErrorWithErr(common.EnumStatusCode_ERR_NOT_FOUND, fmt.Sprintf("member '%s' of subgraph '%s' not found", email, subgraphName), api.ErrNotFound)
+}
@@ -0,0 +1,67 @@
+package subgraph_member_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/acceptance"
+)
+
+func TestAccSubgraphMemberResource(t *testing.T) {
+	namespace := acctest.RandomWithPrefix("test-namespace")
+	subgraphName := acctest.RandomWithPrefix("test-subgraph")
+	routingURL := "https://subgraph-member-example.com"
+
+	// the default user of the local cosmo demo setup
+	email := "foo@wundergraph.com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSubgraphMemberResourceConfig(namespace, subgraphName, routingURL, email),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cosmo_subgraph_member.test", "subgraph", subgraphName),
+					resource.TestCheckResourceAttr("cosmo_subgraph_member.test", "namespace", namespace),
+					resource.TestCheckResourceAttr("cosmo_subgraph_member.test", "email", email),
+					resource.TestCheckResourceAttrSet("cosmo_subgraph_member.test", "id"),
+					resource.TestCheckResourceAttrSet("cosmo_subgraph_member.test", "user_id"),
+				),
+			},
+			{
+				ResourceName:      "cosmo_subgraph_member.test",
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("%s/%s/%s", namespace, subgraphName, email),
+				ImportStateVerify: true,
+			},
+			{
+				Config:  testAccSubgraphMemberResourceConfig(namespace, subgraphName, routingURL, email),
+				Destroy: true,
+			},
+		},
+	})
+}
+
+func testAccSubgraphMemberResourceConfig(namespace, subgraphName, routingURL, email string) string {
+	return fmt.Sprintf(`
+resource "cosmo_namespace" "test" {
+  name = "%s"
+}
+
+resource "cosmo_subgraph" "test" {
+  name        = "%s"
+  namespace   = cosmo_namespace.test.name
+  routing_url = "%s"
+  labels      = { "team" = "backend" }
+}
+
+resource "cosmo_subgraph_member" "test" {
+  subgraph  = cosmo_subgraph.test.name
+  namespace = cosmo_namespace.test.name
+  email     = "%s"
+}
+`, namespace, subgraphName, routingURL, email)
+}
@@ -12,4 +12,10 @@ const (
 	ErrSubgraphSchemaChanged     = "Subgraph Schema Changed"
 	ErrInvalidNamespace          = "Invalid Namespace"
 	ErrSubgraphCompositionFailed = "Subgraph Composition Failed"
+	ErrRollingBackSubgraph       = "Rolling Back Subgraph Schema"
+	ErrWaitingForDeployment      = "Error Waiting For Deployment"
+	ErrReadingSchemaFile         = "Error Reading Schema File"
+	ErrInvalidSubgraphSchema     = "Invalid Subgraph Schema"
+	ErrUnknownSchemaDirective    = "Unknown Schema Directive"
+	ErrSubgraphSchemaLint        = "Subgraph Schema Lint"
 )
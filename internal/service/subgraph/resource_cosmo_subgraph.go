@@ -3,8 +3,11 @@ package subgraph
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -18,6 +21,9 @@ import (
 	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/utils"
 )
 
+var _ resource.ResourceWithModifyPlan = &SubgraphResource{}
+var _ resource.ResourceWithValidateConfig = &SubgraphResource{}
+
 type SubgraphResource struct {
 	client *api.PlatformClient
 }
@@ -38,8 +44,19 @@ type SubgraphResourceModel struct {
 	UnsetLabels          types.Bool   `tfsdk:"unset_labels"`
 	// TBD: This is only used in the update subgraph method and not used atm
 	// Headers              types.List   `tfsdk:"headers"`
-	Labels types.Map    `tfsdk:"labels"`
-	Schema types.String `tfsdk:"schema"`
+	Labels                 types.Map    `tfsdk:"labels"`
+	Schema                 types.String `tfsdk:"schema"`
+	SchemaFile             types.String `tfsdk:"schema_file"`
+	SchemaFiles            types.List   `tfsdk:"schema_files"`
+	SchemaSha256           types.String `tfsdk:"schema_sha256"`
+	CompositionFailureMode types.String `tfsdk:"composition_failure_mode"`
+
+	WaitForDeployment *utils.WaitForDeploymentModel `tfsdk:"wait_for_deployment"`
+	Lint              *SubgraphLintModel            `tfsdk:"lint"`
+}
+
+type SubgraphLintModel struct {
+	Rules types.List `tfsdk:"rules"`
 }
 
 func NewSubgraphResource() resource.Resource {
@@ -142,7 +159,36 @@ For more information on subgraphs, please refer to the [Cosmo Documentation](htt
 			},
 			"schema": schema.StringAttribute{
 				Optional:            true,
-				MarkdownDescription: "The schema for the subgraph.",
+				MarkdownDescription: "The schema for the subgraph. It is parsed by `terraform validate`, syntax errors and duplicate definitions fail the validation and directives unknown to composition are reported as warnings. Schemas read from `schema_file` or `schema_files` are validated the same way.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("schema_file"), path.MatchRoot("schema_files")),
+				},
+			},
+			"schema_file": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The path of a file containing the schema for the subgraph. The provider reads the file itself and only stores its hash in the state, the schema is republished when the hash changes. Rolling back a failed composition needs the inline `schema`, as previous file contents are not stored.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("schema_files")),
+				},
+			},
+			"schema_files": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Paths or glob patterns of the files making up the schema for the subgraph. The files are concatenated in the order of the list, and lexically within a pattern, and must parse as one GraphQL document, syntax errors are reported with the originating file and line. Like `schema_file`, only the hash of the schema is stored in the state.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"schema_sha256": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The sha256 of the published schema.",
+			},
+			"composition_failure_mode": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf("How a failed composition is handled: `%s` reports it as a warning, `%s` fails the apply and `%s` additionally republishes the previously stored schema. Defaults to the `composition_failure_mode` of the provider.", api.CompositionFailureModeWarn, api.CompositionFailureModeError, api.CompositionFailureModeRollback),
+				Validators: []validator.String{
+					stringvalidator.OneOf(api.CompositionFailureModeWarn, api.CompositionFailureModeError, api.CompositionFailureModeRollback),
+				},
 			},
 			// TODO: re-enable this once Graph Feature Flags are implementd
 			// "base_subgraph_name": schema.StringAttribute{
@@ -150,28 +196,162 @@ For more information on subgraphs, please refer to the [Cosmo Documentation](htt
 			// 	MarkdownDescription: "The base subgraph name.",
 			// },
 		},
+		Blocks: map[string]schema.Block{
+			"wait_for_deployment": utils.WaitForDeploymentBlock(),
+			"lint": schema.SingleNestedBlock{
+				MarkdownDescription: fmt.Sprintf("Configures the federation checks run on the schema by `terraform validate`, whether it is inline or read from files. All rules run by default, the available rules are %s.", lintRulesDescription()),
+				Attributes: map[string]schema.Attribute{
+					"rules": schema.ListAttribute{
+						Optional:            true,
+						ElementType:         types.StringType,
+						MarkdownDescription: fmt.Sprintf("The rules to run. When only silenced rules are listed, all other rules run. A rule is silenced by prefixing its name with `%s`, e.g. `%s%s`.", utils.LintRuleSilencePrefix, utils.LintRuleSilencePrefix, utils.LintRuleExternalUnused),
+						Validators: []validator.List{
+							listvalidator.ValueStringsAre(stringvalidator.OneOf(lintRuleValues()...)),
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
-func (r *SubgraphResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+// ValidateConfig parses the schema offline, inline or read from its files, so that syntax errors, duplicate
+// definitions, unknown directives and malformed federation directives are reported by `terraform validate`
+// instead of failing the publish during apply.
+func (r *SubgraphResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
 	var data SubgraphResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	subgraph, apiError := r.createAndPublishSubgraph(ctx, data, resp)
-	if apiError != nil {
-		if api.IsSubgraphCompositionFailedError(apiError) {
-			utils.AddDiagnosticWarning(resp, ErrSubgraphCompositionFailed, apiError.Error())
-		} else if api.IsInvalidSubgraphSchemaError(apiError) {
-			utils.AddDiagnosticError(resp, ErrPublishingSubgraph, apiError.Error())
+	schema, attributePath, locator, ok := configuredSchema(data)
+	if !ok {
+		return
+	}
+
+	for _, problem := range locator.Locate(utils.ValidateSchema(schema)) {
+		if problem.Warning {
+			resp.Diagnostics.AddAttributeWarning(attributePath, ErrUnknownSchemaDirective, problem.String())
+			continue
+		}
+		resp.Diagnostics.AddAttributeError(attributePath, ErrInvalidSubgraphSchema, problem.String())
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var rules []string
+	if data.Lint != nil {
+		if data.Lint.Rules.IsUnknown() {
 			return
-		} else {
-			utils.AddDiagnosticError(resp, ErrPublishingSubgraph, apiError.Error())
+		}
+		resp.Diagnostics.Append(data.Lint.Rules.ElementsAs(ctx, &rules, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	for _, problem := range locator.Locate(utils.LintSchema(schema, utils.EnabledLintRules(rules))) {
+		resp.Diagnostics.AddAttributeError(attributePath, ErrSubgraphSchemaLint, problem.String())
+	}
+}
+
+// configuredSchema returns the schema as far as it is known at validation time, with the attribute it is
+// configured in. Schema files that cannot be read are left to ModifyPlan, which reports them.
+func configuredSchema(data SubgraphResourceModel) (string, path.Path, utils.SchemaFilesLocator, bool) {
+	switch {
+	case !data.SchemaFiles.IsNull():
+		if data.SchemaFiles.IsUnknown() {
+			return "", path.Empty(), nil, false
+		}
+		for _, schemaFile := range data.SchemaFiles.Elements() {
+			if schemaFile.IsUnknown() {
+				return "", path.Empty(), nil, false
+			}
+		}
+
+		schema, locator, err := utils.LoadSchemaFilesWithLocator(data.SchemaFiles)
+		if err != nil {
+			return "", path.Empty(), nil, false
+		}
+		return schema, path.Root("schema_files"), locator, true
+	case !data.SchemaFile.IsNull():
+		if data.SchemaFile.IsUnknown() {
+			return "", path.Empty(), nil, false
+		}
+
+		schema, err := utils.LoadSchema(data.Schema, data.SchemaFile)
+		if err != nil {
+			return "", path.Empty(), nil, false
+		}
+		return schema, path.Root("schema_file"), nil, true
+	default:
+		if data.Schema.IsUnknown() || data.Schema.ValueString() == "" {
+			return "", path.Empty(), nil, false
+		}
+		return data.Schema.ValueString(), path.Root("schema"), nil, true
+	}
+}
+
+// ModifyPlan computes the hash of the schema so that changes to the schema files show up in the plan.
+func (r *SubgraphResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan SubgraphResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Schema.IsUnknown() || plan.SchemaFile.IsUnknown() || plan.SchemaFiles.IsUnknown() {
+		return
+	}
+
+	for _, schemaFile := range plan.SchemaFiles.Elements() {
+		if schemaFile.IsUnknown() {
 			return
 		}
+	}
+
+	schema, err := loadSubgraphSchema(plan)
+	if err != nil {
+		attributePath := path.Root("schema_file")
+		if !plan.SchemaFiles.IsNull() {
+			attributePath = path.Root("schema_files")
+		}
+		resp.Diagnostics.AddAttributeError(attributePath, ErrReadingSchemaFile, err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("schema_sha256"), utils.SchemaSha256Value(schema))...)
+}
+
+func (r *SubgraphResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SubgraphResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	schema, err := loadSubgraphSchema(data)
+	if err != nil {
+		utils.AddDiagnosticError(resp, ErrReadingSchemaFile, err.Error())
+		return
+	}
+
+	if data.SchemaSha256.IsUnknown() {
+		data.SchemaSha256 = utils.SchemaSha256Value(schema)
+	}
+
+	subgraph, apiError := r.createAndPublishSubgraph(ctx, data, schema, resp)
+	if apiError != nil && !api.IsSubgraphCompositionFailedError(apiError) {
 		return
 	}
 
@@ -180,6 +360,19 @@ func (r *SubgraphResource) Create(ctx context.Context, req resource.CreateReques
 	data.Namespace = types.StringValue(subgraph.GetNamespace())
 	data.RoutingURL = types.StringValue(subgraph.GetRoutingURL())
 
+	if apiError != nil {
+		mode := r.compositionFailureMode(data)
+		reportCompositionFailure(resp, mode, apiError)
+		if mode == api.CompositionFailureModeRollback {
+			utils.AddDiagnosticError(resp,
+				ErrRollingBackSubgraph,
+				fmt.Sprintf("Subgraph '%s' has just been created, there is no previously stored schema to roll back to.", data.Name.ValueString()),
+			)
+		}
+	} else {
+		r.waitForDeployment(ctx, resp, data)
+	}
+
 	utils.LogAction(ctx, "created", data.Id.ValueString(), data.Name.ValueString(), data.Namespace.ValueString())
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -225,6 +418,12 @@ func (r *SubgraphResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	schema, loadErr := loadSubgraphSchema(data)
+	if loadErr != nil {
+		utils.AddDiagnosticError(resp, ErrReadingSchemaFile, loadErr.Error())
+		return
+	}
+
 	var labels []*platformv1.Label
 	for key, value := range data.Labels.Elements() {
 		if strValue, ok := value.(types.String); ok {
@@ -240,20 +439,18 @@ func (r *SubgraphResource) Update(ctx context.Context, req resource.UpdateReques
 		unsetLabels = &[]bool{true}[0]
 	}
 
+	mode := r.compositionFailureMode(data)
+
 	// TBD: This is only used in the update subgraph method and not used atm
 	// headers := utils.ConvertHeadersToStringList(data.Headers)
+	compositionFailed := false
 	apiErr := r.client.UpdateSubgraph(ctx, data.Name.ValueString(), data.Namespace.ValueString(), data.RoutingURL.ValueString(), labels, []string{}, data.SubscriptionUrl.ValueStringPointer(), data.Readme.ValueStringPointer(), unsetLabels, data.SubscriptionProtocol.ValueString(), data.WebsocketSubprotocol.ValueString())
 	if apiErr != nil {
 		if api.IsSubgraphCompositionFailedError(apiErr) {
-			utils.AddDiagnosticWarning(resp,
-				ErrSubgraphCompositionFailed,
-				apiErr.Error(),
-			)
+			compositionFailed = true
+			reportCompositionFailure(resp, mode, apiErr)
 		} else {
-			utils.AddDiagnosticError(resp,
-				ErrUpdatingSubgraph,
-				apiErr.Error(),
-			)
+			utils.AddCompositionDiagnostics(resp, diag.SeverityError, ErrUpdatingSubgraph, apiErr.Error(), apiErr.CompositionIssues())
 			return
 		}
 	}
@@ -267,16 +464,35 @@ func (r *SubgraphResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
-	if data.Schema.ValueString() != "" {
-		hasChanged, apiError := r.publishSubgraphSchema(ctx, data)
+	var previousSchemaSha256 types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("schema_sha256"), &previousSchemaSha256)...)
+
+	if data.SchemaSha256.IsUnknown() {
+		data.SchemaSha256 = utils.SchemaSha256Value(schema)
+	}
+
+	if schema != "" && !data.SchemaSha256.Equal(previousSchemaSha256) {
+		hasChanged, apiError := r.publishSubgraphSchema(ctx, data, schema)
 		if apiError != nil {
 			if api.IsSubgraphCompositionFailedError(apiError) {
-				utils.AddDiagnosticWarning(resp, ErrPublishingSubgraph, apiError.Error())
+				compositionFailed = true
+				reportCompositionFailure(resp, mode, apiError)
+
+				if mode != api.CompositionFailureModeWarn {
+					var previousSchema types.String
+					resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("schema"), &previousSchema)...)
+					if mode == api.CompositionFailureModeRollback {
+						r.rollbackSubgraphSchema(ctx, resp, data, previousSchema)
+					}
+					// keep the previously stored schema, so that the next apply publishes the new one again
+					data.Schema = previousSchema
+					data.SchemaSha256 = previousSchemaSha256
+				}
 			} else if api.IsInvalidSubgraphSchemaError(apiError) {
 				utils.AddDiagnosticError(resp, ErrPublishingSubgraph, apiError.Error())
 				return
 			} else {
-				utils.AddDiagnosticError(resp, ErrPublishingSubgraph, apiError.Error())
+				utils.AddCompositionDiagnostics(resp, diag.SeverityError, ErrPublishingSubgraph, apiError.Error(), apiError.CompositionIssues())
 				return
 			}
 		}
@@ -294,6 +510,10 @@ func (r *SubgraphResource) Update(ctx context.Context, req resource.UpdateReques
 	data.Namespace = types.StringValue(subgraph.GetNamespace())
 	data.RoutingURL = types.StringValue(subgraph.GetRoutingURL())
 
+	if !compositionFailed {
+		r.waitForDeployment(ctx, resp, data)
+	}
+
 	utils.LogAction(ctx, "updated", data.Id.ValueString(), data.Name.ValueString(), data.Namespace.ValueString())
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -331,7 +551,7 @@ func (r *SubgraphResource) ImportState(ctx context.Context, req resource.ImportS
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
-func (r *SubgraphResource) createAndPublishSubgraph(ctx context.Context, data SubgraphResourceModel, resp *resource.CreateResponse) (*platformv1.Subgraph, *api.ApiError) {
+func (r *SubgraphResource) createAndPublishSubgraph(ctx context.Context, data SubgraphResourceModel, schema string, resp *resource.CreateResponse) (*platformv1.Subgraph, *api.ApiError) {
 	var labels []*platformv1.Label
 	for key, value := range data.Labels.Elements() {
 		if strValue, ok := value.(types.String); ok {
@@ -353,19 +573,24 @@ func (r *SubgraphResource) createAndPublishSubgraph(ctx context.Context, data Su
 
 	subgraph, apiErr := r.client.GetSubgraph(ctx, data.Name.ValueString(), data.Namespace.ValueString())
 	if apiErr != nil {
+		utils.AddDiagnosticError(resp,
+			ErrRetrievingSubgraph,
+			apiErr.Error(),
+		)
 		return nil, apiErr
 	}
 
-	if data.Schema.ValueString() != "" {
-		hasChanged, apiError := r.publishSubgraphSchema(ctx, data)
+	if schema != "" {
+		hasChanged, apiError := r.publishSubgraphSchema(ctx, data, schema)
 		if apiError != nil {
 			if api.IsSubgraphCompositionFailedError(apiError) {
-				utils.AddDiagnosticWarning(resp, ErrSubgraphCompositionFailed, apiError.Error())
+				// the failure is reported by the caller, according to the composition failure mode
+				return subgraph, apiError
 			} else if api.IsInvalidSubgraphSchemaError(apiError) {
 				utils.AddDiagnosticError(resp, ErrPublishingSubgraph, apiError.Error())
 				return nil, apiError
 			} else {
-				utils.AddDiagnosticError(resp, ErrPublishingSubgraph, apiError.Error())
+				utils.AddCompositionDiagnostics(resp, diag.SeverityError, ErrPublishingSubgraph, apiError.Error(), apiError.CompositionIssues())
 				return nil, apiError
 			}
 		}
@@ -381,8 +606,34 @@ func (r *SubgraphResource) createAndPublishSubgraph(ctx context.Context, data Su
 	return subgraph, nil
 }
 
-func (r *SubgraphResource) publishSubgraphSchema(ctx context.Context, data SubgraphResourceModel) (bool, *api.ApiError) {
-	apiResponse, apiError := r.client.PublishSubgraph(ctx, data.Name.ValueString(), data.Namespace.ValueString(), data.Schema.ValueString())
+// lintRuleValues returns the accepted values of `lint.rules`, every rule and its silenced form.
+func lintRuleValues() []string {
+	var values []string
+	for _, rule := range utils.LintRules {
+		values = append(values, rule, utils.LintRuleSilencePrefix+rule)
+	}
+	return values
+}
+
+func lintRulesDescription() string {
+	var rules []string
+	for _, rule := range utils.LintRules {
+		rules = append(rules, "`"+rule+"`")
+	}
+	return strings.Join(rules, ", ")
+}
+
+// loadSubgraphSchema returns the schema to publish from either `schema`, `schema_file` or `schema_files`.
+func loadSubgraphSchema(data SubgraphResourceModel) (string, error) {
+	if !data.SchemaFiles.IsNull() {
+		return utils.LoadSchemaFiles(data.SchemaFiles)
+	}
+
+	return utils.LoadSchema(data.Schema, data.SchemaFile)
+}
+
+func (r *SubgraphResource) publishSubgraphSchema(ctx context.Context, data SubgraphResourceModel, schema string) (bool, *api.ApiError) {
+	apiResponse, apiError := r.client.PublishSubgraph(ctx, data.Name.ValueString(), data.Namespace.ValueString(), schema)
 	if apiError != nil {
 		return false, apiError
 	}
@@ -393,3 +644,64 @@ func (r *SubgraphResource) publishSubgraphSchema(ctx context.Context, data Subgr
 
 	return false, nil
 }
+
+func (r *SubgraphResource) compositionFailureMode(data SubgraphResourceModel) string {
+	return r.client.ResolveCompositionFailureMode(data.CompositionFailureMode.ValueString())
+}
+
+// reportCompositionFailure reports a failed composition as a warning in `warn` mode and as an error otherwise.
+func reportCompositionFailure(resp interface{}, mode string, apiError *api.ApiError) {
+	utils.AddCompositionDiagnostics(resp, api.CompositionFailureSeverity(mode), ErrSubgraphCompositionFailed, apiError.Error(), apiError.CompositionIssues())
+}
+
+// rollbackSubgraphSchema republishes the previously stored schema after the composition of a new schema failed.
+func (r *SubgraphResource) rollbackSubgraphSchema(ctx context.Context, resp *resource.UpdateResponse, data SubgraphResourceModel, previousSchema types.String) {
+	if previousSchema.ValueString() == "" {
+		utils.AddDiagnosticError(resp,
+			ErrRollingBackSubgraph,
+			fmt.Sprintf("Subgraph '%s' has no previously stored schema to roll back to.", data.Name.ValueString()),
+		)
+		return
+	}
+
+	_, apiError := r.client.PublishSubgraph(ctx, data.Name.ValueString(), data.Namespace.ValueString(), previousSchema.ValueString())
+	if apiError != nil {
+		utils.AddCompositionDiagnostics(resp, diag.SeverityError, ErrRollingBackSubgraph, apiError.Error(), apiError.CompositionIssues())
+		return
+	}
+
+	utils.AddDiagnosticWarning(resp,
+		ErrRollingBackSubgraph,
+		fmt.Sprintf("The previously stored schema of subgraph '%s' has been republished.", data.Name.ValueString()),
+	)
+}
+
+// waitForDeployment waits for the deployment of every federated graph the subgraph is part of.
+func (r *SubgraphResource) waitForDeployment(ctx context.Context, resp interface{}, data SubgraphResourceModel) {
+	if data.WaitForDeployment == nil {
+		return
+	}
+
+	timeout, pollInterval := data.WaitForDeployment.Durations()
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	graphs, apiError := r.client.GetFederatedGraphsBySubgraphLabels(ctx, data.Name.ValueString(), data.Namespace.ValueString())
+	if apiError != nil {
+		utils.AddDiagnosticError(resp, ErrWaitingForDeployment, apiError.Error())
+		return
+	}
+
+	for _, graph := range graphs {
+		apiError := r.client.WaitForDeployment(ctx, graph.GetName(), graph.GetNamespace(), pollInterval)
+		if apiError != nil {
+			// a failed composition is reported by the apply itself, there is just nothing to wait for
+			if api.IsSubgraphCompositionFailedError(apiError) {
+				utils.AddDiagnosticWarning(resp, ErrWaitingForDeployment, apiError.Error())
+				continue
+			}
+			utils.AddDiagnosticError(resp, ErrWaitingForDeployment, apiError.Error())
+			return
+		}
+	}
+}
@@ -1,7 +1,11 @@
 package subgraph_test
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"os"
+	"path/filepath"
 	"regexp"
 	"testing"
 
@@ -117,7 +121,7 @@ func TestAccSubgraphResourceInvalidSchema(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				Config:      testAccSubgraphResourceConfig(namespace, federatedGraphName, federatedGraphRoutingURL, subgraphName, subgraphRoutingURL, subgraphSchema),
-				ExpectError: regexp.MustCompile(`.*ERR_INVALID_SUBGRAPH_SCHEMA*`),
+				ExpectError: regexp.MustCompile(`Invalid Subgraph Schema`),
 			},
 		},
 	})
@@ -147,7 +151,7 @@ func TestAccStandaloneSubgraphResourcePublishSchema(t *testing.T) {
 			},
 			{
 				Config:      testStandaloneSubgraph(namespace, subgraphName, subgraphRoutingURL, updatedSubgraphSchema),
-				ExpectError: regexp.MustCompile(`.*ERR_INVALID_SUBGRAPH_SCHEMA*`),
+				ExpectError: regexp.MustCompile(`Invalid Subgraph Schema`),
 			},
 		},
 	})
@@ -203,3 +207,249 @@ resource "cosmo_subgraph" "test" {
 }
 `, namespace, subgraphName, subgraphRoutingURL, subgraphSchema)
 }
+
+func TestAccSubgraphResourceCompositionFailureMode(t *testing.T) {
+	namespace := acctest.RandomWithPrefix("test-namespace")
+	federatedGraphName := acctest.RandomWithPrefix("test-federated-graph")
+	subgraphName := acctest.RandomWithPrefix("test-subgraph")
+	conflictingSubgraphName := acctest.RandomWithPrefix("test-subgraph")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSubgraphCompositionFailureModeConfig(namespace, federatedGraphName, subgraphName, conflictingSubgraphName, "rollback", "type Query { world: String }"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cosmo_subgraph.conflicting", "composition_failure_mode", "rollback"),
+				),
+			},
+			{
+				Config:      testAccSubgraphCompositionFailureModeConfig(namespace, federatedGraphName, subgraphName, conflictingSubgraphName, "rollback", "type Query { hello: Int }"),
+				ExpectError: regexp.MustCompile(`Subgraph Composition Failed`),
+			},
+			{
+				Config:      testAccSubgraphCompositionFailureModeConfig(namespace, federatedGraphName, subgraphName, conflictingSubgraphName, "error", "type Query { hello: Int }"),
+				ExpectError: regexp.MustCompile(`Subgraph Composition Failed`),
+			},
+			{
+				// the failed schema is not stored, so applying again publishes it and fails again
+				Config:      testAccSubgraphCompositionFailureModeConfig(namespace, federatedGraphName, subgraphName, conflictingSubgraphName, "error", "type Query { hello: Int }"),
+				ExpectError: regexp.MustCompile(`Subgraph Composition Failed`),
+			},
+		},
+	})
+}
+
+func testAccSubgraphCompositionFailureModeConfig(namespace, federatedGraphName, subgraphName, conflictingSubgraphName, compositionFailureMode, conflictingSchema string) string {
+	return fmt.Sprintf(`
+resource "cosmo_namespace" "test" {
+  name = "%s"
+}
+
+resource "cosmo_federated_graph" "test" {
+  name           = "%s"
+  namespace      = cosmo_namespace.test.name
+  routing_url    = "https://federated-graph-composition-failure-example.com"
+  label_matchers = ["team=backend"]
+
+  depends_on = [cosmo_subgraph.test]
+}
+
+resource "cosmo_subgraph" "test" {
+  name        = "%s"
+  namespace   = cosmo_namespace.test.name
+  routing_url = "https://subgraph-composition-failure-example.com"
+  schema      = "type Query { hello: String }"
+  labels      = {
+    "team" = "backend"
+  }
+}
+
+resource "cosmo_subgraph" "conflicting" {
+  name                     = "%s"
+  namespace                = cosmo_namespace.test.name
+  routing_url              = "https://conflicting-subgraph-composition-failure-example.com"
+  composition_failure_mode = "%s"
+  schema                   = "%s"
+  labels                   = {
+    "team" = "backend"
+  }
+
+  depends_on = [cosmo_federated_graph.test]
+}
+`, namespace, federatedGraphName, subgraphName, conflictingSubgraphName, compositionFailureMode, conflictingSchema)
+}
+
+func TestAccSubgraphResourceSchemaFile(t *testing.T) {
+	namespace := acctest.RandomWithPrefix("test-namespace")
+	subgraphName := acctest.RandomWithPrefix("test-subgraph")
+	subgraphRoutingURL := "https://subgraph-schema-file-example.com"
+
+	schemaFile := filepath.Join(t.TempDir(), "schema.graphql")
+	subgraphSchema := acceptance.TestAccValidSubgraphSchema
+	updatedSubgraphSchema := "type Query { hello: String }"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() { writeSchemaFile(t, schemaFile, subgraphSchema) },
+				Config:    testAccSubgraphSchemaFileConfig(namespace, subgraphName, subgraphRoutingURL, schemaFile),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cosmo_subgraph.test", "schema_file", schemaFile),
+					resource.TestCheckResourceAttr("cosmo_subgraph.test", "schema_sha256", schemaSha256(subgraphSchema)),
+					resource.TestCheckNoResourceAttr("cosmo_subgraph.test", "schema"),
+				),
+			},
+			{
+				PreConfig: func() { writeSchemaFile(t, schemaFile, updatedSubgraphSchema) },
+				Config:    testAccSubgraphSchemaFileConfig(namespace, subgraphName, subgraphRoutingURL, schemaFile),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cosmo_subgraph.test", "schema_sha256", schemaSha256(updatedSubgraphSchema)),
+				),
+			},
+		},
+	})
+}
+
+func testAccSubgraphSchemaFileConfig(namespace, subgraphName, subgraphRoutingURL, schemaFile string) string {
+	return fmt.Sprintf(`
+resource "cosmo_namespace" "test" {
+  name = "%s"
+}
+
+resource "cosmo_subgraph" "test" {
+  name        = "%s"
+  namespace   = cosmo_namespace.test.name
+  routing_url = "%s"
+  schema_file = "%s"
+}
+`, namespace, subgraphName, subgraphRoutingURL, schemaFile)
+}
+
+func writeSchemaFile(t *testing.T, path, schema string) {
+	if err := os.WriteFile(path, []byte(schema), 0o600); err != nil {
+		t.Fatalf("could not write schema file: %s", err)
+	}
+}
+
+func schemaSha256(schema string) string {
+	sum := sha256.Sum256([]byte(schema))
+	return hex.EncodeToString(sum[:])
+}
+
+func TestAccSubgraphResourceSchemaFiles(t *testing.T) {
+	namespace := acctest.RandomWithPrefix("test-namespace")
+	subgraphName := acctest.RandomWithPrefix("test-subgraph")
+	subgraphRoutingURL := "https://subgraph-schema-files-example.com"
+
+	schemaDirectory := t.TempDir()
+	typesFile := filepath.Join(schemaDirectory, "types.graphql")
+	queryFile := filepath.Join(schemaDirectory, "query.graphql")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					writeSchemaFile(t, typesFile, "type User {\n  id: ID!\n}\n")
+					writeSchemaFile(t, queryFile, "type Query {\n  users: [User!]!\n}\n")
+				},
+				Config: testAccSubgraphSchemaFilesConfig(namespace, subgraphName, subgraphRoutingURL, filepath.Join(schemaDirectory, "*.graphql")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cosmo_subgraph.test", "schema_sha256", schemaSha256("type Query {\n  users: [User!]!\n}\ntype User {\n  id: ID!\n}\n")),
+				),
+			},
+			{
+				PreConfig:   func() { writeSchemaFile(t, typesFile, "type User {\n  id: ID!\n") },
+				Config:      testAccSubgraphSchemaFilesConfig(namespace, subgraphName, subgraphRoutingURL, filepath.Join(schemaDirectory, "*.graphql")),
+				ExpectError: regexp.MustCompile(`invalid GraphQL schema at .*types\.graphql`),
+			},
+		},
+	})
+}
+
+func testAccSubgraphSchemaFilesConfig(namespace, subgraphName, subgraphRoutingURL, schemaFilesPattern string) string {
+	return fmt.Sprintf(`
+resource "cosmo_namespace" "test" {
+  name = "%s"
+}
+
+resource "cosmo_subgraph" "test" {
+  name         = "%s"
+  namespace    = cosmo_namespace.test.name
+  routing_url  = "%s"
+  schema_files = ["%s"]
+}
+`, namespace, subgraphName, subgraphRoutingURL, schemaFilesPattern)
+}
+
+func TestAccSubgraphResourceSchemaLint(t *testing.T) {
+	namespace := acctest.RandomWithPrefix("test-namespace")
+	subgraphName := acctest.RandomWithPrefix("test-subgraph")
+
+	schema := `type Query { product: Product } type Product @key(fields: \"id\") { id: ID! weight: Int @external }`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSubgraphSchemaLintConfig(namespace, subgraphName, schema, `[]`),
+				ExpectError: regexp.MustCompile(`Product.weight is marked @external`),
+			},
+			{
+				Config: testAccSubgraphSchemaLintConfig(namespace, subgraphName, schema, `["!external_unused"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cosmo_subgraph.test", "lint.rules.0", "!external_unused"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSubgraphSchemaLintConfig(namespace, subgraphName, schema, rules string) string {
+	return fmt.Sprintf(`
+resource "cosmo_namespace" "test" {
+  name = "%s"
+}
+
+resource "cosmo_subgraph" "test" {
+  name        = "%s"
+  namespace   = cosmo_namespace.test.name
+  routing_url = "https://subgraph-schema-lint-example.com"
+  schema      = "%s"
+
+  lint {
+    rules = %s
+  }
+}
+`, namespace, subgraphName, schema, rules)
+}
+
+func TestAccSubgraphResourceSchemaFilesLint(t *testing.T) {
+	namespace := acctest.RandomWithPrefix("test-namespace")
+	subgraphName := acctest.RandomWithPrefix("test-subgraph")
+
+	schemaDirectory := t.TempDir()
+	productFile := filepath.Join(schemaDirectory, "product.graphql")
+	queryFile := filepath.Join(schemaDirectory, "query.graphql")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					writeSchemaFile(t, productFile, "type Product @key(fields: \"id\") {\n  id: ID!\n  weight: Int @external\n}\n")
+					writeSchemaFile(t, queryFile, "type Query {\n  product: Product\n}\n")
+				},
+				Config:      testAccSubgraphSchemaFilesConfig(namespace, subgraphName, "https://subgraph-schema-files-lint-example.com", filepath.Join(schemaDirectory, "*.graphql")),
+				ExpectError: regexp.MustCompile(`product\.graphql:3:\d+:\s+Product\.weight\s+is\s+marked\s+@external`),
+			},
+		},
+	})
+}
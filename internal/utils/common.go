@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -14,6 +15,7 @@ import (
 const (
 	EnvCosmoApiUrl = "COSMO_API_URL"
 	EnvCosmoApiKey = "COSMO_API_KEY"
+	EnvCosmoCdnUrl = "COSMO_CDN_URL"
 )
 
 // convertLabelMatchers converts a Terraform list of strings to a slice of strings for use in the gRPC request.
@@ -34,8 +36,12 @@ func ConvertLabelMatchers(labelMatchersList types.List) ([]string, error) {
 	return labelMatchers, nil
 }
 
+// ConvertAndValidateLabelMatchers converts the label matchers and checks that they parse, reporting errors on the response.
 func ConvertAndValidateLabelMatchers(data types.List, resp interface{}) ([]string, error) {
 	labelMatchers, err := ConvertLabelMatchers(data)
+	if err == nil {
+		_, err = ParseLabelMatchers(labelMatchers)
+	}
 	if err != nil {
 		switch r := resp.(type) {
 		case *resource.CreateResponse:
@@ -111,6 +117,39 @@ func AddDiagnosticWarning(resp interface{}, title, message string) {
 	}
 }
 
+// CompositionIssue is a composition or deployment error reported by the platform for a federated graph.
+type CompositionIssue interface {
+	GetMessage() string
+	GetFederatedGraphName() string
+	GetNamespace() string
+}
+
+// AddCompositionDiagnostics adds one diagnostic per composition issue, naming the affected federated graph
+// and namespace. When there are no issues, a single diagnostic with the fallback message is added instead.
+func AddCompositionDiagnostics(resp interface{}, severity diag.Severity, title, fallback string, issues []CompositionIssue) {
+	addDiagnostic := AddDiagnosticWarning
+	if severity == diag.SeverityError {
+		addDiagnostic = AddDiagnosticError
+	}
+
+	if len(issues) == 0 {
+		addDiagnostic(resp, title, fallback)
+		return
+	}
+
+	for _, issue := range issues {
+		addDiagnostic(resp, title, FormatCompositionIssue(issue))
+	}
+}
+
+func FormatCompositionIssue(issue CompositionIssue) string {
+	graph := fmt.Sprintf("federated graph '%s' in namespace '%s'", issue.GetFederatedGraphName(), issue.GetNamespace())
+	if featureFlagIssue, ok := issue.(interface{ GetFeatureFlag() string }); ok && featureFlagIssue.GetFeatureFlag() != "" {
+		graph = fmt.Sprintf("%s (feature flag '%s')", graph, featureFlagIssue.GetFeatureFlag())
+	}
+	return fmt.Sprintf("%s: %s", graph, issue.GetMessage())
+}
+
 func LogAction(ctx context.Context, action, resourceID, name, namespace string) {
 	tflog.Trace(ctx, fmt.Sprintf("%s federated graph resource", action), map[string]interface{}{
 		"id":        resourceID,
@@ -21,3 +21,18 @@ func ConvertHeadersToStringList(headersList types.List) []string {
 	}
 	return headers
 }
+
+// StringValueForUpdate returns the value of an optional attribute to send on update: nil when it was
+// never set so the remote value is kept, and an empty string when it was removed from the configuration
+// so the remote value is cleared.
+func StringValueForUpdate(plan, state types.String) *string {
+	if !plan.IsNull() {
+		value := plan.ValueString()
+		return &value
+	}
+	if !state.IsNull() {
+		empty := ""
+		return &empty
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// labelPartRegex matches the keys and values of labels accepted by cosmo.
+var labelPartRegex = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9_.-]*[a-zA-Z0-9])?$`)
+
+const maxLabelPartLength = 63
+
+// Label is a single `key=value` label of a label matcher.
+type Label struct {
+	Key   string
+	Value string
+}
+
+// ParseLabelMatcher parses a label matcher in the syntax of cosmo: comma separated `key=value` labels, of which
+// a subgraph needs to have at least one to be matched.
+func ParseLabelMatcher(matcher string) ([]Label, error) {
+	if matcher == "" {
+		return nil, fmt.Errorf("label matcher is empty")
+	}
+
+	var labels []Label
+	for i, label := range strings.Split(matcher, ",") {
+		if label == "" {
+			return nil, fmt.Errorf("label matcher %q: label %d is empty", matcher, i+1)
+		}
+
+		key, value, ok := strings.Cut(label, "=")
+		if !ok || strings.Contains(value, "=") {
+			return nil, fmt.Errorf("label matcher %q: label %q must be in the format key=value", matcher, label)
+		}
+
+		if err := validateLabelPart("key", key); err != nil {
+			return nil, fmt.Errorf("label matcher %q: label %q: %w", matcher, label, err)
+		}
+		if err := validateLabelPart("value", value); err != nil {
+			return nil, fmt.Errorf("label matcher %q: label %q: %w", matcher, label, err)
+		}
+
+		labels = append(labels, Label{Key: key, Value: value})
+	}
+
+	return labels, nil
+}
+
+// ParseLabelMatchers parses every label matcher of the list, a subgraph needs to match all of them.
+func ParseLabelMatchers(matchers []string) ([][]Label, error) {
+	var parsed [][]Label
+	for _, matcher := range matchers {
+		labels, err := ParseLabelMatcher(matcher)
+		if err != nil {
+			return nil, err
+		}
+		parsed = append(parsed, labels)
+	}
+	return parsed, nil
+}
+
+func validateLabelPart(name, part string) error {
+	if part == "" {
+		return fmt.Errorf("%s is empty", name)
+	}
+	if len(part) > maxLabelPartLength {
+		return fmt.Errorf("%s is longer than %d characters", name, maxLabelPartLength)
+	}
+	if !labelPartRegex.MatchString(part) {
+		return fmt.Errorf("%s must start and end with an alphanumeric character and only contain alphanumeric characters, '-', '_' or '.'", name)
+	}
+	return nil
+}
+
+// LabelMatchersValidator validates every element of a list of label matchers with ParseLabelMatcher.
+func LabelMatchersValidator() validator.List {
+	return labelMatchersValidator{}
+}
+
+type labelMatchersValidator struct{}
+
+func (v labelMatchersValidator) Description(ctx context.Context) string {
+	return "each label matcher must be a comma separated list of key=value labels"
+}
+
+func (v labelMatchersValidator) MarkdownDescription(ctx context.Context) string {
+	return "each label matcher must be a comma separated list of `key=value` labels"
+}
+
+func (v labelMatchersValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for i, element := range req.ConfigValue.Elements() {
+		matcher, ok := element.(types.String)
+		if !ok || matcher.IsNull() || matcher.IsUnknown() {
+			continue
+		}
+
+		if _, err := ParseLabelMatcher(matcher.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(req.Path.AtListIndex(i), "Invalid Label Matchers", err.Error())
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package utils_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/utils"
+)
+
+func TestParseLabelMatcher(t *testing.T) {
+	labels, err := utils.ParseLabelMatcher("team=backend,env=prod.eu-1")
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+
+	expected := []utils.Label{{Key: "team", Value: "backend"}, {Key: "env", Value: "prod.eu-1"}}
+	if !reflect.DeepEqual(labels, expected) {
+		t.Errorf("Expected labels %v, got %v", expected, labels)
+	}
+}
+
+func TestParseLabelMatcherInvalid(t *testing.T) {
+	cases := map[string]string{
+		"":              "label matcher is empty",
+		"team=a,,env=b": "label 2 is empty",
+		"team=a,":       "label 2 is empty",
+		"team":          "must be in the format key=value",
+		"team=a=b":      "must be in the format key=value",
+		"=backend":      "key is empty",
+		"team=":         "value is empty",
+		"team=-a":       "value must start and end with an alphanumeric character",
+		"te am=a":       "key must start and end with an alphanumeric character",
+	}
+
+	for matcher, expected := range cases {
+		_, err := utils.ParseLabelMatcher(matcher)
+		if err == nil || !strings.Contains(err.Error(), expected) {
+			t.Errorf("Expected error containing %q for %q, got %v", expected, matcher, err)
+		}
+	}
+}
+
+func TestParseLabelMatchers(t *testing.T) {
+	if _, err := utils.ParseLabelMatchers([]string{"team=a", "env=prod,env=staging"}); err != nil {
+		t.Errorf("Expected no error, got %s", err)
+	}
+
+	if _, err := utils.ParseLabelMatchers([]string{"team=a", "env=prod,,"}); err == nil {
+		t.Error("Expected an error for the second matcher, got none")
+	}
+}
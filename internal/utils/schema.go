@@ -0,0 +1,168 @@
+package utils
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"github.com/vektah/gqlparser/v2/parser"
+)
+
+// LoadSchema returns the schema to publish, either the inline schema or the contents of the schema file.
+func LoadSchema(schema, schemaFile types.String) (string, error) {
+	if schemaFile.IsNull() || schemaFile.ValueString() == "" {
+		return schema.ValueString(), nil
+	}
+
+	content, err := os.ReadFile(schemaFile.ValueString())
+	if err != nil {
+		return "", fmt.Errorf("could not read schema file '%s': %w", schemaFile.ValueString(), err)
+	}
+
+	return string(content), nil
+}
+
+// SchemaSha256Value returns the hex encoded sha256 of the schema, or null when there is no schema.
+func SchemaSha256Value(schema string) types.String {
+	if schema == "" {
+		return types.StringNull()
+	}
+
+	sum := sha256.Sum256([]byte(schema))
+	return types.StringValue(hex.EncodeToString(sum[:]))
+}
+
+// schemaFileSegment is the part of a concatenated schema that originates from a single file.
+type schemaFileSegment struct {
+	path      string
+	startLine int
+	lines     int
+}
+
+// SchemaFilesLocator maps the lines of a schema concatenated from several files back to the files.
+type SchemaFilesLocator []schemaFileSegment
+
+// LoadSchemaFiles concatenates the files of the paths and glob patterns in the list, in the order of the list
+// and lexically within a pattern, and validates that they parse as one GraphQL document. Syntax errors are
+// reported with the originating file and line.
+func LoadSchemaFiles(schemaFiles types.List) (string, error) {
+	schema, _, err := LoadSchemaFilesWithLocator(schemaFiles)
+	return schema, err
+}
+
+// LoadSchemaFilesWithLocator is LoadSchemaFiles, additionally returning the locator of the concatenated schema.
+func LoadSchemaFilesWithLocator(schemaFiles types.List) (string, SchemaFilesLocator, error) {
+	paths, err := expandSchemaFiles(schemaFiles)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var builder strings.Builder
+	var segments []schemaFileSegment
+	line := 1
+	for _, path := range paths {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return "", nil, fmt.Errorf("could not read schema file '%s': %w", path, err)
+		}
+
+		text := string(content)
+		if !strings.HasSuffix(text, "\n") {
+			text += "\n"
+		}
+
+		lines := strings.Count(text, "\n")
+		segments = append(segments, schemaFileSegment{path: path, startLine: line, lines: lines})
+		builder.WriteString(text)
+		line += lines
+	}
+
+	schema := builder.String()
+	locator := SchemaFilesLocator(segments)
+	if _, err := parser.ParseSchema(&ast.Source{Input: schema}); err != nil {
+		return "", nil, locator.locateError(err)
+	}
+
+	return schema, locator, nil
+}
+
+func expandSchemaFiles(schemaFiles types.List) ([]string, error) {
+	var paths []string
+	seen := make(map[string]bool)
+
+	for _, element := range schemaFiles.Elements() {
+		pattern, ok := element.(types.String)
+		if !ok {
+			return nil, fmt.Errorf("expected string type in schema_files, got: %T", element)
+		}
+
+		matches := []string{pattern.ValueString()}
+		if strings.ContainsAny(pattern.ValueString(), "*?[") {
+			globbed, err := filepath.Glob(pattern.ValueString())
+			if err != nil {
+				return nil, fmt.Errorf("invalid schema file pattern '%s': %w", pattern.ValueString(), err)
+			}
+			if len(globbed) == 0 {
+				return nil, fmt.Errorf("schema file pattern '%s' does not match any file", pattern.ValueString())
+			}
+			sort.Strings(globbed)
+			matches = globbed
+		}
+
+		for _, match := range matches {
+			if seen[match] {
+				continue
+			}
+			seen[match] = true
+			paths = append(paths, match)
+		}
+	}
+
+	return paths, nil
+}
+
+// Locate maps the problems found in the concatenated schema back to the files they originate from.
+func (l SchemaFilesLocator) Locate(problems []SchemaProblem) []SchemaProblem {
+	located := make([]SchemaProblem, 0, len(problems))
+	for _, problem := range problems {
+		if len(l) > 0 && problem.Line > 0 {
+			segment := l.segment(problem.Line)
+			problem.File = segment.path
+			problem.Line = problem.Line - segment.startLine + 1
+		}
+		located = append(located, problem)
+	}
+	return located
+}
+
+// segment returns the file a line of the concatenated schema originates from. Lines past the end of the
+// document, like the one of an unexpected EOF, belong to the last file.
+func (l SchemaFilesLocator) segment(line int) schemaFileSegment {
+	for _, candidate := range l {
+		if line < candidate.startLine+candidate.lines {
+			return candidate
+		}
+	}
+	return l[len(l)-1]
+}
+
+// locateError maps the line of a syntax error in the concatenated schema back to the file it originates from.
+func (l SchemaFilesLocator) locateError(err error) error {
+	var gqlErr *gqlerror.Error
+	if !errors.As(err, &gqlErr) || len(gqlErr.Locations) == 0 || len(l) == 0 {
+		return fmt.Errorf("invalid GraphQL schema: %w", err)
+	}
+
+	location := gqlErr.Locations[0]
+	segment := l.segment(location.Line)
+
+	return fmt.Errorf("invalid GraphQL schema at %s:%d:%d: %s", segment.path, location.Line-segment.startLine+1, location.Column, gqlErr.Message)
+}
@@ -0,0 +1,182 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/parser"
+)
+
+const (
+	LintRuleKeyFields      = "key_fields"
+	LintRuleRequiresFields = "requires_fields"
+	LintRuleProvidesFields = "provides_fields"
+	LintRuleExternalUnused = "external_unused"
+
+	// LintRuleSilencePrefix silences a rule when prepended to its name.
+	LintRuleSilencePrefix = "!"
+)
+
+// LintRules are the federation checks run on subgraph schemas.
+var LintRules = []string{LintRuleKeyFields, LintRuleRequiresFields, LintRuleProvidesFields, LintRuleExternalUnused}
+
+// EnabledLintRules resolves the configured rules: the listed rules, or all rules when none is listed, without
+// the silenced ones.
+func EnabledLintRules(rules []string) map[string]bool {
+	enabled := make(map[string]bool)
+	var silenced []string
+	for _, rule := range rules {
+		if strings.HasPrefix(rule, LintRuleSilencePrefix) {
+			silenced = append(silenced, strings.TrimPrefix(rule, LintRuleSilencePrefix))
+			continue
+		}
+		enabled[rule] = true
+	}
+
+	if len(enabled) == 0 {
+		for _, rule := range LintRules {
+			enabled[rule] = true
+		}
+	}
+
+	for _, rule := range silenced {
+		delete(enabled, rule)
+	}
+
+	return enabled
+}
+
+// schemaLinter checks the federation directives of a subgraph schema against the fields it defines. Types
+// that are not defined in the schema are owned by other subgraphs and are not checked.
+type schemaLinter struct {
+	rules    map[string]bool
+	fields   map[string]map[string]*ast.FieldDefinition
+	used     map[string]bool
+	problems []SchemaProblem
+}
+
+// LintSchema runs the enabled federation rules on the schema. Schemas that don't parse are left to ValidateSchema.
+func LintSchema(schema string, rules map[string]bool) []SchemaProblem {
+	document, err := parser.ParseSchema(&ast.Source{Input: schema})
+	if err != nil {
+		return nil
+	}
+
+	linter := &schemaLinter{
+		rules:  rules,
+		fields: make(map[string]map[string]*ast.FieldDefinition),
+		used:   make(map[string]bool),
+	}
+
+	for _, definitions := range []ast.DefinitionList{document.Definitions, document.Extensions} {
+		for _, definition := range definitions {
+			if linter.fields[definition.Name] == nil {
+				linter.fields[definition.Name] = make(map[string]*ast.FieldDefinition)
+			}
+			for _, field := range definition.Fields {
+				linter.fields[definition.Name][field.Name] = field
+			}
+		}
+	}
+
+	for _, definitions := range []ast.DefinitionList{document.Definitions, document.Extensions} {
+		for _, definition := range definitions {
+			linter.lintDefinition(definition)
+		}
+	}
+
+	if rules[LintRuleExternalUnused] {
+		for _, definitions := range []ast.DefinitionList{document.Definitions, document.Extensions} {
+			for _, definition := range definitions {
+				linter.lintExternalFields(definition)
+			}
+		}
+	}
+
+	return linter.problems
+}
+
+func (l *schemaLinter) lintDefinition(definition *ast.Definition) {
+	for _, key := range definition.Directives.ForNames("key") {
+		l.lintFieldSet(LintRuleKeyFields, key, definition.Name, definition.Name, false)
+	}
+
+	for _, field := range definition.Fields {
+		coordinate := definition.Name + "." + field.Name
+
+		for _, requires := range field.Directives.ForNames("requires") {
+			l.lintFieldSet(LintRuleRequiresFields, requires, coordinate, definition.Name, true)
+		}
+
+		for _, provides := range field.Directives.ForNames("provides") {
+			l.lintFieldSet(LintRuleProvidesFields, provides, coordinate, field.Type.Name(), false)
+		}
+	}
+}
+
+// lintFieldSet checks that the `fields` argument of the directive is a field set selecting fields of the type,
+// and, for `@requires`, that the selected fields are external.
+func (l *schemaLinter) lintFieldSet(rule string, directive *ast.Directive, coordinate, typeName string, external bool) {
+	argument := directive.Arguments.ForName("fields")
+	if argument == nil || (argument.Value.Kind != ast.StringValue && argument.Value.Kind != ast.BlockValue) {
+		l.report(rule, directive.Position, fmt.Sprintf("@%s on %s needs a `fields` string argument", directive.Name, coordinate))
+		return
+	}
+
+	document, err := parser.ParseQuery(&ast.Source{Input: "{" + argument.Value.Raw + "}"})
+	if err != nil || len(document.Operations) != 1 {
+		l.report(rule, argument.Value.Position, fmt.Sprintf("@%s on %s has an invalid field set %q", directive.Name, coordinate, argument.Value.Raw))
+		return
+	}
+
+	l.lintSelections(rule, directive, coordinate, typeName, document.Operations[0].SelectionSet, external, argument.Value.Position)
+}
+
+func (l *schemaLinter) lintSelections(rule string, directive *ast.Directive, coordinate, typeName string, selections ast.SelectionSet, external bool, position *ast.Position) {
+	fields, ok := l.fields[typeName]
+	if !ok {
+		return
+	}
+
+	for _, selection := range selections {
+		field, ok := selection.(*ast.Field)
+		if !ok {
+			continue
+		}
+
+		definition, ok := fields[field.Name]
+		if !ok {
+			l.report(rule, position, fmt.Sprintf("@%s on %s selects %s.%s, which is not defined", directive.Name, coordinate, typeName, field.Name))
+			continue
+		}
+
+		l.used[typeName+"."+field.Name] = true
+
+		if external && definition.Directives.ForName("external") == nil {
+			l.report(rule, position, fmt.Sprintf("@%s on %s selects %s.%s, which is not marked @external", directive.Name, coordinate, typeName, field.Name))
+		}
+
+		if len(field.SelectionSet) > 0 {
+			// nested selections only need to exist, external is only required on the top level
+			l.lintSelections(rule, directive, coordinate, definition.Type.Name(), field.SelectionSet, false, position)
+		}
+	}
+}
+
+func (l *schemaLinter) lintExternalFields(definition *ast.Definition) {
+	for _, field := range definition.Fields {
+		external := field.Directives.ForName("external")
+		if external == nil || l.used[definition.Name+"."+field.Name] {
+			continue
+		}
+		l.report(LintRuleExternalUnused, external.Position, fmt.Sprintf("%s.%s is marked @external but not used by any @key, @requires or @provides", definition.Name, field.Name))
+	}
+}
+
+func (l *schemaLinter) report(rule string, position *ast.Position, message string) {
+	if !l.rules[rule] {
+		return
+	}
+	l.problems = append(l.problems, positionedProblem(position, fmt.Sprintf("%s (%s)", message, rule), false))
+}
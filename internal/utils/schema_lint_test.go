@@ -0,0 +1,78 @@
+package utils_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/utils"
+)
+
+const lintedSchema = `
+type Product @key(fields: "id sku { code }") {
+  id: ID!
+  sku: Sku!
+  weight: Int @external
+  price: Int @external
+  shippingEstimate: Int @requires(fields: "weight")
+  reviews: [Review!]! @provides(fields: "author")
+}
+
+type Sku {
+  code: String!
+}
+
+type Review {
+  author: String @external
+}
+`
+
+func TestLintSchemaValid(t *testing.T) {
+	schema := strings.Replace(lintedSchema, "  price: Int @external\n", "", 1)
+
+	if problems := utils.LintSchema(schema, utils.EnabledLintRules(nil)); len(problems) != 0 {
+		t.Errorf("Expected no problems, got %v", problems)
+	}
+}
+
+func TestLintSchemaExternalUnused(t *testing.T) {
+	problems := utils.LintSchema(lintedSchema, utils.EnabledLintRules(nil))
+	if len(problems) != 1 || !strings.Contains(problems[0].Message, "Product.price is marked @external") {
+		t.Fatalf("Expected Product.price to be reported as unused, got %v", problems)
+	}
+
+	if problems := utils.LintSchema(lintedSchema, utils.EnabledLintRules([]string{"!" + utils.LintRuleExternalUnused})); len(problems) != 0 {
+		t.Errorf("Expected the silenced rule not to report, got %v", problems)
+	}
+}
+
+func TestLintSchemaFieldSets(t *testing.T) {
+	schema := `
+type Product @key(fields: "upc") @key(fields: 42) {
+  id: ID!
+  weight: Int
+  shippingEstimate: Int @requires(fields: "weight")
+  related: Product @provides(fields: "id {")
+}
+`
+
+	expected := []string{
+		"@key on Product selects Product.upc, which is not defined (key_fields)",
+		"@key on Product needs a `fields` string argument (key_fields)",
+		"@requires on Product.shippingEstimate selects Product.weight, which is not marked @external (requires_fields)",
+		"@provides on Product.related has an invalid field set \"id {\" (provides_fields)",
+	}
+
+	problems := utils.LintSchema(schema, utils.EnabledLintRules(nil))
+	if len(problems) != len(expected) {
+		t.Fatalf("Expected %d problems, got %v", len(expected), problems)
+	}
+	for i, problem := range problems {
+		if problem.Message != expected[i] {
+			t.Errorf("Expected problem %q, got %q", expected[i], problem.Message)
+		}
+	}
+
+	if problems := utils.LintSchema(schema, utils.EnabledLintRules([]string{utils.LintRuleRequiresFields})); len(problems) != 1 {
+		t.Errorf("Expected only the enabled rule to report, got %v", problems)
+	}
+}
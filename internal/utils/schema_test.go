@@ -0,0 +1,99 @@
+package utils_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/utils"
+)
+
+func writeSchemaFiles(t *testing.T, files map[string]string) string {
+	directory := t.TempDir()
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(directory, name), []byte(content), 0o600); err != nil {
+			t.Fatalf("could not write schema file: %s", err)
+		}
+	}
+	return directory
+}
+
+func schemaFilesValue(paths ...string) types.List {
+	var values []attr.Value
+	for _, path := range paths {
+		values = append(values, types.StringValue(path))
+	}
+	return types.ListValueMust(types.StringType, values)
+}
+
+func TestLoadSchemaFilesConcatenatesInOrder(t *testing.T) {
+	directory := writeSchemaFiles(t, map[string]string{
+		"b.graphql":     "type Product { id: ID! }",
+		"a.graphql":     "type User { id: ID! }\n",
+		"query.graphql": "type Query { users: [User!]! products: [Product!]! }\n",
+	})
+
+	schema, err := utils.LoadSchemaFiles(schemaFilesValue(
+		filepath.Join(directory, "query.graphql"),
+		filepath.Join(directory, "*.graphql"),
+	))
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+
+	expected := "type Query { users: [User!]! products: [Product!]! }\ntype User { id: ID! }\ntype Product { id: ID! }\n"
+	if schema != expected {
+		t.Errorf("Expected schema %q, got %q", expected, schema)
+	}
+}
+
+func TestLoadSchemaFilesReportsOriginatingFile(t *testing.T) {
+	directory := writeSchemaFiles(t, map[string]string{
+		"a.graphql": "type User {\n  id: ID!\n}\n",
+		"b.graphql": "type Query {\n  users: [User!]!\n  broken(: String\n}\n",
+	})
+
+	_, err := utils.LoadSchemaFiles(schemaFilesValue(filepath.Join(directory, "*.graphql")))
+	if err == nil {
+		t.Fatal("Expected a syntax error, got none")
+	}
+
+	expected := filepath.Join(directory, "b.graphql") + ":3:"
+	if !strings.Contains(err.Error(), expected) {
+		t.Errorf("Expected error to point at %s, got %s", expected, err)
+	}
+}
+
+func TestLoadSchemaFilesUnmatchedPattern(t *testing.T) {
+	directory := t.TempDir()
+
+	_, err := utils.LoadSchemaFiles(schemaFilesValue(filepath.Join(directory, "*.graphql")))
+	if err == nil || !strings.Contains(err.Error(), "does not match any file") {
+		t.Errorf("Expected an unmatched pattern error, got %v", err)
+	}
+}
+
+func TestSchemaFilesLocatorLocate(t *testing.T) {
+	directory := writeSchemaFiles(t, map[string]string{
+		"a.graphql": "type User {\n  id: ID!\n}\n",
+		"b.graphql": "type Query {\n  users: [User!]! @unknown\n}\n",
+	})
+
+	schema, locator, err := utils.LoadSchemaFilesWithLocator(schemaFilesValue(filepath.Join(directory, "*.graphql")))
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+
+	problems := locator.Locate(utils.ValidateSchema(schema))
+	if len(problems) != 1 {
+		t.Fatalf("Expected one problem, got %v", problems)
+	}
+
+	expected := filepath.Join(directory, "b.graphql") + ":2:"
+	if !strings.HasPrefix(problems[0].String(), expected) {
+		t.Errorf("Expected problem to point at %s, got %s", expected, problems[0])
+	}
+}
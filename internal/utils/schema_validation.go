@@ -0,0 +1,172 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"github.com/vektah/gqlparser/v2/parser"
+)
+
+// knownSchemaDirectives are the directives a subgraph schema can use without defining them: the GraphQL
+// built-ins and the federation and event-driven directives supported by cosmo composition.
+var knownSchemaDirectives = map[string]bool{
+	"deprecated":                 true,
+	"include":                    true,
+	"oneOf":                      true,
+	"skip":                       true,
+	"specifiedBy":                true,
+	"authenticated":              true,
+	"composeDirective":           true,
+	"configureChildDescriptions": true,
+	"configureDescription":       true,
+	"extends":                    true,
+	"external":                   true,
+	"inaccessible":               true,
+	"interfaceObject":            true,
+	"key":                        true,
+	"link":                       true,
+	"override":                   true,
+	"provides":                   true,
+	"requires":                   true,
+	"requiresScopes":             true,
+	"shareable":                  true,
+	"subscriptionFilter":         true,
+	"tag":                        true,
+}
+
+// knownSchemaDirectivePrefixes are the namespaces of directives imported through `@link` or provided for event-driven graphs.
+var knownSchemaDirectivePrefixes = []string{"federation__", "edfs__", "openfed__"}
+
+// SchemaProblem is an issue found in a schema, located by line and column, and by file for schemas read from files.
+type SchemaProblem struct {
+	Message string
+	File    string
+	Line    int
+	Column  int
+	// Warning marks problems that don't necessarily fail the composition, like directives unknown to the provider.
+	Warning bool
+}
+
+func (p SchemaProblem) String() string {
+	if p.File != "" {
+		return fmt.Sprintf("%s:%d:%d: %s", p.File, p.Line, p.Column, p.Message)
+	}
+	return fmt.Sprintf("line %d, column %d: %s", p.Line, p.Column, p.Message)
+}
+
+// schemaDirectiveUsage is a directive applied somewhere in a schema, with the coordinate it is applied to.
+type schemaDirectiveUsage struct {
+	Directive  *ast.Directive
+	Coordinate string
+}
+
+// ValidateSchema parses the schema offline and reports syntax errors, duplicate type and directive definitions
+// and directives that are neither defined in the schema nor known to composition.
+func ValidateSchema(schema string) []SchemaProblem {
+	document, err := parser.ParseSchema(&ast.Source{Input: schema})
+	if err != nil {
+		return []SchemaProblem{syntaxProblem(err)}
+	}
+
+	var problems []SchemaProblem
+
+	definedTypes := make(map[string]bool)
+	for _, definition := range document.Definitions {
+		if definedTypes[definition.Name] {
+			problems = append(problems, positionedProblem(definition.Position, fmt.Sprintf("type %q is defined more than once", definition.Name), false))
+			continue
+		}
+		definedTypes[definition.Name] = true
+	}
+
+	definedDirectives := make(map[string]bool)
+	for _, directive := range document.Directives {
+		if definedDirectives[directive.Name] {
+			problems = append(problems, positionedProblem(directive.Position, fmt.Sprintf("directive @%s is defined more than once", directive.Name), false))
+			continue
+		}
+		definedDirectives[directive.Name] = true
+	}
+
+	for _, usage := range schemaDirectiveUsages(document) {
+		name := usage.Directive.Name
+		if definedDirectives[name] || isKnownSchemaDirective(name) {
+			continue
+		}
+		problems = append(problems, positionedProblem(usage.Directive.Position, fmt.Sprintf("directive @%s on %s is not defined in the schema and not known to composition", name, usage.Coordinate), true))
+	}
+
+	return problems
+}
+
+func isKnownSchemaDirective(name string) bool {
+	if knownSchemaDirectives[name] {
+		return true
+	}
+	for _, prefix := range knownSchemaDirectivePrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// schemaDirectiveUsages collects every directive applied in the schema, each definition before its fields and arguments.
+func schemaDirectiveUsages(document *ast.SchemaDocument) []schemaDirectiveUsage {
+	var usages []schemaDirectiveUsage
+	add := func(coordinate string, directives ast.DirectiveList) {
+		for _, directive := range directives {
+			usages = append(usages, schemaDirectiveUsage{Directive: directive, Coordinate: coordinate})
+		}
+	}
+
+	for _, schemaDefinitions := range []ast.SchemaDefinitionList{document.Schema, document.SchemaExtension} {
+		for _, schemaDefinition := range schemaDefinitions {
+			add("schema", schemaDefinition.Directives)
+		}
+	}
+
+	for _, directive := range document.Directives {
+		for _, argument := range directive.Arguments {
+			add(fmt.Sprintf("@%s(%s:)", directive.Name, argument.Name), argument.Directives)
+		}
+	}
+
+	for _, definitions := range []ast.DefinitionList{document.Definitions, document.Extensions} {
+		for _, definition := range definitions {
+			add(definition.Name, definition.Directives)
+			for _, field := range definition.Fields {
+				coordinate := definition.Name + "." + field.Name
+				add(coordinate, field.Directives)
+				for _, argument := range field.Arguments {
+					add(fmt.Sprintf("%s(%s:)", coordinate, argument.Name), argument.Directives)
+				}
+			}
+			for _, value := range definition.EnumValues {
+				add(definition.Name+"."+value.Name, value.Directives)
+			}
+		}
+	}
+
+	return usages
+}
+
+func syntaxProblem(err error) SchemaProblem {
+	var gqlErr *gqlerror.Error
+	if errors.As(err, &gqlErr) && len(gqlErr.Locations) > 0 {
+		return SchemaProblem{Message: gqlErr.Message, Line: gqlErr.Locations[0].Line, Column: gqlErr.Locations[0].Column}
+	}
+	return SchemaProblem{Message: err.Error()}
+}
+
+func positionedProblem(position *ast.Position, message string, warning bool) SchemaProblem {
+	problem := SchemaProblem{Message: message, Warning: warning}
+	if position != nil {
+		problem.Line = position.Line
+		problem.Column = position.Column
+	}
+	return problem
+}
@@ -0,0 +1,83 @@
+package utils_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/utils"
+)
+
+func TestValidateSchemaValid(t *testing.T) {
+	schema := `
+directive @rateLimit(max: Int) on FIELD_DEFINITION
+
+type User @key(fields: "id") {
+  id: ID!
+  name: String @rateLimit(max: 10) @deprecated(reason: "use fullName")
+}
+
+extend type Query {
+  users: [User!]! @shareable
+}
+`
+
+	if problems := utils.ValidateSchema(schema); len(problems) != 0 {
+		t.Errorf("Expected no problems, got %v", problems)
+	}
+}
+
+func TestValidateSchemaSyntaxError(t *testing.T) {
+	problems := utils.ValidateSchema("type Query {\n  hello: String\n")
+	if len(problems) != 1 || problems[0].Warning {
+		t.Fatalf("Expected one syntax error, got %v", problems)
+	}
+
+	if problems[0].Line != 3 {
+		t.Errorf("Expected the syntax error on line 3, got %s", problems[0])
+	}
+}
+
+func TestValidateSchemaDuplicateDefinitions(t *testing.T) {
+	schema := `
+directive @audit on FIELD_DEFINITION
+directive @audit on FIELD_DEFINITION
+
+type Query { hello: String }
+type Query { world: String }
+`
+
+	problems := utils.ValidateSchema(schema)
+	if len(problems) != 2 {
+		t.Fatalf("Expected two problems, got %v", problems)
+	}
+
+	expected := []string{
+		`line 6, column 6: type "Query" is defined more than once`,
+		`line 3, column 12: directive @audit is defined more than once`,
+	}
+	for i, problem := range problems {
+		if problem.Warning || problem.String() != expected[i] {
+			t.Errorf("Expected error %q, got %q (warning: %t)", expected[i], problem.String(), problem.Warning)
+		}
+	}
+}
+
+func TestValidateSchemaUnknownDirective(t *testing.T) {
+	problems := utils.ValidateSchema("type Query {\n  hello(name: String @trim): String @cache\n}\n")
+	if len(problems) != 2 {
+		t.Fatalf("Expected two problems, got %v", problems)
+	}
+
+	for _, problem := range problems {
+		if !problem.Warning {
+			t.Errorf("Expected unknown directives to be warnings, got %s", problem)
+		}
+	}
+
+	if !strings.Contains(problems[0].Message, "@cache on Query.hello") {
+		t.Errorf("Expected the field directive first, got %s", problems[0])
+	}
+	if !strings.Contains(problems[1].Message, "@trim on Query.hello(name:)") {
+		t.Errorf("Expected the argument directive second, got %s", problems[1])
+	}
+}
@@ -0,0 +1,60 @@
+package utils
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	DefaultWaitForDeploymentTimeout      = 5 * time.Minute
+	DefaultWaitForDeploymentPollInterval = 5 * time.Second
+)
+
+var durationRegex = regexp.MustCompile(`^([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$`)
+
+// WaitForDeploymentModel describes the `wait_for_deployment` block of the resources publishing schemas.
+type WaitForDeploymentModel struct {
+	Timeout      types.String `tfsdk:"timeout"`
+	PollInterval types.String `tfsdk:"poll_interval"`
+}
+
+// WaitForDeploymentBlock returns the schema of the `wait_for_deployment` block.
+func WaitForDeploymentBlock() schema.SingleNestedBlock {
+	return schema.SingleNestedBlock{
+		MarkdownDescription: "When set, the apply waits until the latest composition of the affected federated graphs has been deployed, i.e. its router config is served by the CDN, or the timeout elapses.",
+		Attributes: map[string]schema.Attribute{
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "How long to wait for the deployment, as a duration like `90s` or `5m`. Defaults to `5m`.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(durationRegex, "must be a duration like 90s or 5m"),
+				},
+			},
+			"poll_interval": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "How often the deployment status is polled, as a duration like `5s`. Defaults to `5s`.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(durationRegex, "must be a duration like 5s"),
+				},
+			},
+		},
+	}
+}
+
+// Durations returns the configured timeout and poll interval, falling back to the defaults.
+func (m *WaitForDeploymentModel) Durations() (time.Duration, time.Duration) {
+	return parseDurationOrDefault(m.Timeout, DefaultWaitForDeploymentTimeout), parseDurationOrDefault(m.PollInterval, DefaultWaitForDeploymentPollInterval)
+}
+
+func parseDurationOrDefault(value types.String, defaultDuration time.Duration) time.Duration {
+	duration, err := time.ParseDuration(value.ValueString())
+	if err != nil || duration <= 0 {
+		return defaultDuration
+	}
+	return duration
+}